@@ -0,0 +1,74 @@
+package ncpdp
+
+// IsHuman reports whether the patient is a HumanPatient. A Patient with
+// neither branch populated is treated as human.
+func (p Patient) IsHuman() bool {
+	return p.NonHumanPatient == nil
+}
+
+// Name returns the human patient's name, or the owner's name for an animal.
+func (p Patient) Name() Name {
+	if p.NonHumanPatient != nil {
+		return p.NonHumanPatient.Name
+	}
+
+	return p.HumanPatient.Name
+}
+
+func (p Patient) Gender() string {
+	if p.NonHumanPatient != nil {
+		return p.NonHumanPatient.Gender
+	}
+
+	return p.HumanPatient.Gender
+}
+
+func (p Patient) DateOfBirth() Date {
+	if p.NonHumanPatient != nil {
+		return p.NonHumanPatient.DateOfBirth.Date
+	}
+
+	return p.HumanPatient.DateOfBirth.Date
+}
+
+func (p Patient) Address() Address {
+	if p.NonHumanPatient != nil {
+		return p.NonHumanPatient.Address
+	}
+
+	return p.HumanPatient.Address
+}
+
+func (p Patient) CommunicationNumbers() CommunicationNumbers {
+	if p.NonHumanPatient != nil {
+		return p.NonHumanPatient.CommunicationNumbers
+	}
+
+	return p.HumanPatient.CommunicationNumbers
+}
+
+func (p Prescriber) IsVeterinarian() bool {
+	return p.Veterinarian != nil
+}
+
+// Provider returns whichever prescriber branch is populated. A Veterinarian
+// is returned in the NonVeterinarian shape, which has the same fields.
+func (p Prescriber) Provider() NonVeterinarian {
+	if p.Veterinarian != nil {
+		return NonVeterinarian(*p.Veterinarian)
+	}
+
+	return p.NonVeterinarian
+}
+
+func (s Supervisor) IsVeterinarian() bool {
+	return s.Veterinarian != nil
+}
+
+func (s Supervisor) Provider() NonVeterinarian {
+	if s.Veterinarian != nil {
+		return NonVeterinarian(*s.Veterinarian)
+	}
+
+	return s.NonVeterinarian
+}
@@ -0,0 +1,108 @@
+package ncpdp
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestPatientAndPrescriberAccessors(t *testing.T) {
+	tests := []struct {
+		name             string
+		file             string
+		wantHuman        bool
+		wantName         string
+		wantDOB          string
+		wantVeterinarian bool
+		wantPrescriber   string
+	}{
+		{
+			name:             "human patient",
+			file:             "testdata/sample-newrx.xml",
+			wantHuman:        true,
+			wantName:         "Jenny",
+			wantDOB:          "1984-09-09",
+			wantVeterinarian: false,
+			wantPrescriber:   "Bless",
+		},
+		{
+			name:             "animal patient",
+			file:             "testdata/sample-newrx-veterinary.xml",
+			wantHuman:        false,
+			wantName:         "Alvarez",
+			wantDOB:          "2018-04-02",
+			wantVeterinarian: true,
+			wantPrescriber:   "Bless",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			msg, err := NewDecoder(file).Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			patient := msg.Body.NewRx.Patient
+			if got := patient.IsHuman(); got != tt.wantHuman {
+				t.Errorf("IsHuman() = %v, want %v", got, tt.wantHuman)
+			}
+			if got := patient.Name().LastName; got != tt.wantName {
+				t.Errorf("Name() = %v, want %v", got, tt.wantName)
+			}
+			if got := patient.DateOfBirth().Format("2006-01-02"); got != tt.wantDOB {
+				t.Errorf("DateOfBirth() = %v, want %v", got, tt.wantDOB)
+			}
+			if patient.Address().City != "Miami" {
+				t.Errorf("Address() = %+v", patient.Address())
+			}
+
+			prescriber := msg.Body.NewRx.Prescriber
+			if got := prescriber.IsVeterinarian(); got != tt.wantVeterinarian {
+				t.Errorf("IsVeterinarian() = %v, want %v", got, tt.wantVeterinarian)
+			}
+			provider := prescriber.Provider()
+			if provider.Name.LastName != tt.wantPrescriber {
+				t.Errorf("Provider() = %+v", provider)
+			}
+			if provider.Identification.NPI != "1939842031" {
+				t.Errorf("Provider().Identification = %+v", provider.Identification)
+			}
+		})
+	}
+}
+
+func TestEncodeVeterinary(t *testing.T) {
+	file, err := os.Open("testdata/sample-newrx-veterinary.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	msg, err := NewDecoder(file).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.Body.NewRx.Patient.NonHumanPatient.AnimalName != "Biscuit" {
+		t.Errorf("AnimalName = %v", msg.Body.NewRx.Patient.NonHumanPatient.AnimalName)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := NewEncoder(buf).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, unwanted := range []string{"<HumanPatient>", "<NonVeterinarian>"} {
+		if strings.Contains(buf.String(), unwanted) {
+			t.Errorf("Encode() = %s, unexpected %s", buf, unwanted)
+		}
+	}
+}
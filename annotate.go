@@ -0,0 +1,119 @@
+package ncpdp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+// Annotation records the terminology entry a coded field resolved to.
+type Annotation struct {
+	Path string
+	Code string
+	Term *Terminology
+}
+
+// Annotate resolves the NCIt codes of every QuantityUnitOfMeasure,
+// StrengthForm, StrengthUnitOfMeasure, DoseUnitOfMeasure and DEASchedule in m
+// against the subset each field is drawn from. UnitOfMeasure elements without
+// Text are filled with the preferred term. Codes that are unknown or belong
+// to another subset are returned as ValidationErrors alongside the
+// annotations that did resolve.
+//
+// A Measurement UnitOfMeasure is only resolved when it has the form of an
+// NCIt code, a C followed by digits. Other units, such as UCUM units, are
+// neither annotated nor reported; ValidateMeasurements checks those.
+func (m *Message) Annotate(terms *TerminologyIndex) ([]Annotation, error) {
+	if terms == nil {
+		return nil, errors.New("ncpdp: cannot annotate without terminology")
+	}
+
+	a := &annotator{terms: terms}
+	walkElements("", reflect.ValueOf(m), a.visit)
+	if len(a.errs) == 0 {
+		return a.annotations, nil
+	}
+
+	return a.annotations, a.errs
+}
+
+var ncitCode = regexp.MustCompile(`^C[0-9]+$`)
+
+type annotator struct {
+	terms       *TerminologyIndex
+	annotations []Annotation
+	errs        ValidationErrors
+}
+
+func (a *annotator) visit(path string, x interface{}) {
+	switch x := x.(type) {
+	case *Quantity:
+		a.unit(join(path, "QuantityUnitOfMeasure"), &x.QuantityUnitOfMeasure, SubsetQuantityUnitOfMeasure)
+
+	case *Strength:
+		if x.StrengthForm != nil {
+			a.unit(join(path, "StrengthForm"), x.StrengthForm, SubsetStrengthForm)
+		}
+		if x.StrengthUnitOfMeasure != nil {
+			a.unit(join(path, "StrengthUnitOfMeasure"), x.StrengthUnitOfMeasure, SubsetStrengthUnitOfMeasure)
+		}
+
+	case *Dosage:
+		a.unit(join(path, "DoseUnitOfMeasure"), &x.DoseUnitOfMeasure, SubsetDoseUnitOfMeasure)
+
+	case *DEASchedule:
+		if x.Code != "" {
+			a.resolve(join(path, "Code"), x.Code, SubsetDEASchedule)
+		}
+
+	case *Measurement:
+		if ncitCode.MatchString(x.UnitOfMeasure) {
+			a.resolve(join(path, "UnitOfMeasure"), x.UnitOfMeasure, SubsetMeasurementUnitCode)
+		}
+	}
+}
+
+func (a *annotator) unit(path string, u *UnitOfMeasure, subset string) {
+	if u.Code == nil || *u.Code == "" {
+		return
+	}
+
+	term := a.resolve(join(path, "Code"), *u.Code, subset)
+	if term != nil && (u.Text == nil || *u.Text == "") {
+		text := term.PreferredTerm
+		u.Text = &text
+	}
+}
+
+func (a *annotator) resolve(path, code, subset string) *Terminology {
+	if term := a.terms.Find(subset, code); term != nil {
+		a.annotations = append(a.annotations, Annotation{Path: path, Code: code, Term: term})
+		return term
+	}
+
+	message := fmt.Sprintf("unknown %s code", subsetName(subset))
+	if others := a.terms.FindByCode(code); len(others) > 0 {
+		message = fmt.Sprintf("%s code used as %s", subsetName(others[0].NCItSubsetCode), subsetName(subset))
+	}
+
+	a.errs = append(a.errs, &ValidationError{
+		Path:            path,
+		Value:           code,
+		Message:         message,
+		Code:            ErrorTransactionRejected,
+		DescriptionCode: DescriptionInvalidValue,
+	})
+
+	return nil
+}
+
+func subsetName(code string) string {
+	for name, c := range subsetCodes {
+		if c == code {
+			return name
+		}
+	}
+
+	return code
+}
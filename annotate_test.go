@@ -0,0 +1,117 @@
+package ncpdp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAnnotate(t *testing.T) {
+	terms, err := LoadTerminologyIndex(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := decodeFile(t, "testdata/sample-newrx.xml")
+	med := &msg.Body.NewRx.MedicationPrescribed
+	form, unit, dose := "C78746", "C48672", "C99999"
+	med.DrugCoded.Strength = &Strength{
+		StrengthValue:         "8",
+		StrengthForm:          &UnitOfMeasure{Code: &form},
+		StrengthUnitOfMeasure: &UnitOfMeasure{Code: &unit},
+	}
+	med.DrugCoded.DEASchedule = &DEASchedule{Code: "C48675"}
+	med.Sig.Instruction = &Instruction{
+		DoseAdministration: DoseAdministration{
+			Dosage: Dosage{DoseQuantity: 1, DoseUnitOfMeasure: UnitOfMeasure{Code: &dose}},
+		},
+	}
+
+	annotations, err := msg.Annotate(terms)
+
+	const prefix = "Body/NewRx/MedicationPrescribed/"
+	wantAnnotations := map[string]string{
+		prefix + "Quantity/QuantityUnitOfMeasure/Code":  "Tablet",
+		prefix + "DrugCoded/Strength/StrengthForm/Code": "21 Day Tablet",
+		prefix + "DrugCoded/DEASchedule/Code":           "Schedule II Substance",
+	}
+	if len(annotations) != len(wantAnnotations) {
+		t.Errorf("Annotate() returned %d annotations, want %d", len(annotations), len(wantAnnotations))
+	}
+	for _, a := range annotations {
+		if want := wantAnnotations[a.Path]; a.Term.PreferredTerm != want {
+			t.Errorf("annotation %s = %v, want %v", a.Path, a.Term.PreferredTerm, want)
+		}
+	}
+
+	if text := med.Quantity.QuantityUnitOfMeasure.Text; text == nil || *text != "Tablet" {
+		t.Errorf("QuantityUnitOfMeasure.Text = %v, want Tablet", text)
+	}
+	if text := med.DrugCoded.Strength.StrengthForm.Text; text == nil || *text != "21 Day Tablet" {
+		t.Errorf("StrengthForm.Text = %v, want 21 Day Tablet", text)
+	}
+	if text := med.DrugCoded.Strength.StrengthUnitOfMeasure.Text; text != nil {
+		t.Errorf("StrengthUnitOfMeasure.Text = %v, want nil", *text)
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Annotate() error = %v, want ValidationErrors", err)
+	}
+
+	wantErrs := map[string]string{
+		prefix + "DrugCoded/Strength/StrengthUnitOfMeasure/Code":                    "DEASchedule code used as StrengthUnitOfMeasure",
+		prefix + "Sig/Instruction/DoseAdministration/Dosage/DoseUnitOfMeasure/Code": "unknown DoseUnitOfMeasure code",
+	}
+	if len(errs) != len(wantErrs) {
+		t.Errorf("Annotate() error = %v, want %d violations", err, len(wantErrs))
+	}
+	for _, e := range errs {
+		if e.Message != wantErrs[e.Path] {
+			t.Errorf("violation %s = %q, want %q", e.Path, e.Message, wantErrs[e.Path])
+		}
+	}
+}
+
+func TestAnnotateMeasurement(t *testing.T) {
+	terms, err := LoadTerminologyIndex(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := decodeFile(t, "testdata/sample-clinicalinforesponse.xml")
+	if annotations, err := msg.Annotate(terms); err != nil || len(annotations) != 0 {
+		t.Fatalf("Annotate() = %v, %v, want UCUM units skipped", annotations, err)
+	}
+
+	m := &msg.Body.ClinicalInfoResponse.Observation.Measurement[0]
+	m.UnitOfMeasure = "C28252"
+	annotations, err := msg.Annotate(terms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(annotations) != 1 || annotations[0].Term.PreferredTerm != "Kilogram" {
+		t.Errorf("Annotate() = %+v, want Kilogram", annotations)
+	}
+
+	tests := []struct {
+		unit    string
+		wantErr bool
+	}{
+		{unit: "kg"},
+		{unit: "c28252"},
+		{unit: "C28252a"},
+		{unit: "C99999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		m.UnitOfMeasure = tt.unit
+		annotations, err := msg.Annotate(terms)
+		if len(annotations) != 0 || (err != nil) != tt.wantErr {
+			t.Errorf("Annotate() with unit %q = %+v, %v, wantErr %v", tt.unit, annotations, err, tt.wantErr)
+		}
+	}
+
+	if _, err := msg.Annotate(nil); err == nil {
+		t.Error("Annotate(nil) error = nil")
+	}
+}
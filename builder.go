@@ -0,0 +1,166 @@
+package ncpdp
+
+import (
+	"time"
+)
+
+// RxBuilder composes a NewRx message. Build fills the version attributes
+// and Header defaults and validates the result.
+type RxBuilder struct {
+	msg           *Message
+	middleName    *string
+	quantity      *Quantity
+	substitutions *int
+	refills       *int
+}
+
+func NewRxBuilder() *RxBuilder {
+	return &RxBuilder{
+		msg: &Message{
+			DatatypesVersion:   Version,
+			TransportVersion:   Version,
+			TransactionDomain:  "SCRIPT",
+			TransactionVersion: Version,
+			StructuresVersion:  Version,
+			ECLVersion:         Version,
+			Body:               Body{NewRx: &NewRx{}},
+		},
+	}
+}
+
+func (b *RxBuilder) To(qualifier, value string) *RxBuilder {
+	b.msg.Header.To = QualifierRef{Qualifier: qualifier, Value: value}
+	return b
+}
+
+func (b *RxBuilder) From(qualifier, value string) *RxBuilder {
+	b.msg.Header.From = QualifierRef{Qualifier: qualifier, Value: value}
+	return b
+}
+
+func (b *RxBuilder) MessageID(id string) *RxBuilder {
+	b.msg.Header.MessageID = id
+	return b
+}
+
+func (b *RxBuilder) PrescriberOrderNumber(number string) *RxBuilder {
+	b.msg.Header.PrescriberOrderNumber = number
+	return b
+}
+
+func (b *RxBuilder) SenderSoftware(developer, product, version string) *RxBuilder {
+	b.msg.Header.SenderSoftware = SenderSoftware{
+		SenderSoftwareDeveloper:      developer,
+		SenderSoftwareProduct:        product,
+		SenderSoftwareVersionRelease: version,
+	}
+	return b
+}
+
+func (b *RxBuilder) Patient(p HumanPatient) *RxBuilder {
+	b.msg.Body.NewRx.Patient = Patient{HumanPatient: p}
+	return b
+}
+
+func (b *RxBuilder) NonHumanPatient(p NonHumanPatient) *RxBuilder {
+	b.msg.Body.NewRx.Patient = Patient{NonHumanPatient: &p}
+	return b
+}
+
+// PatientMiddleName sets the middle name of the human patient.
+func (b *RxBuilder) PatientMiddleName(name string) *RxBuilder {
+	b.middleName = &name
+	return b
+}
+
+func (b *RxBuilder) Pharmacy(p Pharmacy) *RxBuilder {
+	b.msg.Body.NewRx.Pharmacy = p
+	return b
+}
+
+func (b *RxBuilder) Prescriber(p NonVeterinarian) *RxBuilder {
+	b.msg.Body.NewRx.Prescriber = Prescriber{NonVeterinarian: p}
+	return b
+}
+
+func (b *RxBuilder) Veterinarian(v Veterinarian) *RxBuilder {
+	b.msg.Body.NewRx.Prescriber = Prescriber{Veterinarian: &v}
+	return b
+}
+
+func (b *RxBuilder) Medication(m Medication) *RxBuilder {
+	b.msg.Body.NewRx.MedicationPrescribed = m
+	return b
+}
+
+// Quantity sets the prescribed quantity, e.g. Quantity(30, "38", "C48542").
+func (b *RxBuilder) Quantity(value float64, codeListQualifier, unitOfMeasureCode string) *RxBuilder {
+	b.quantity = &Quantity{
+		Value:                 value,
+		CodeListQualifier:     codeListQualifier,
+		QuantityUnitOfMeasure: UnitOfMeasure{Code: &unitOfMeasureCode},
+	}
+	return b
+}
+
+func (b *RxBuilder) Substitutions(n int) *RxBuilder {
+	b.substitutions = &n
+	return b
+}
+
+func (b *RxBuilder) NumberOfRefills(n int) *RxBuilder {
+	b.refills = &n
+	return b
+}
+
+func (b *RxBuilder) Observation(o Observation) *RxBuilder {
+	b.msg.Body.NewRx.Observation = &o
+	return b
+}
+
+// Build returns a new message composed from the builder, defaulting
+// MessageID, SentTime and the WrittenDate to today. The error is a
+// ValidationErrors when required fields are missing. Later builder calls do
+// not change a message that was already built.
+func (b *RxBuilder) Build() (*Message, error) {
+	msg := *b.msg
+	rx := *b.msg.Body.NewRx
+	msg.Body.NewRx = &rx
+
+	if msg.Header.MessageID == "" {
+		msg.Header.MessageID = NewMessageID()
+	}
+	if msg.Header.SentTime.IsZero() {
+		msg.Header.SentTime = time.Now().UTC()
+	}
+
+	if b.middleName != nil && rx.Patient.IsHuman() {
+		name := *b.middleName
+		rx.Patient.HumanPatient.Name.MiddleName = &name
+	}
+
+	med := &rx.MedicationPrescribed
+	if b.quantity != nil {
+		med.Quantity = *b.quantity
+		code := *b.quantity.QuantityUnitOfMeasure.Code
+		med.Quantity.QuantityUnitOfMeasure.Code = &code
+	}
+	if b.substitutions != nil {
+		n := *b.substitutions
+		med.Substitutions = &n
+	}
+	if b.refills != nil {
+		n := *b.refills
+		med.NumberOfRefills = &n
+	}
+	if med.WrittenDate.DateTime == nil && med.WrittenDate.Date == nil {
+		now := time.Now().UTC()
+		med.WrittenDate.Date = &Date{time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)}
+	}
+
+	if err := Validate(&msg); err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
@@ -0,0 +1,241 @@
+package ncpdp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRxBuilder(t *testing.T) {
+	sample := decodeFile(t, "testdata/sample-newrx.xml")
+	rx := sample.Body.NewRx
+
+	med := rx.MedicationPrescribed
+	med.Substitutions, med.NumberOfRefills = nil, nil
+
+	msg, err := NewRxBuilder().
+		To("P", "6557744").
+		From("D", "6128890368017").
+		PrescriberOrderNumber("515537246945306").
+		SenderSoftware("Elation Health", "ElationEMR", "3.0").
+		Patient(rx.Patient.HumanPatient).
+		Pharmacy(rx.Pharmacy).
+		Prescriber(rx.Prescriber.NonVeterinarian).
+		Medication(med).
+		Substitutions(0).
+		NumberOfRefills(0).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	want, _ := json.Marshal(sample.Body)
+	got, _ := json.Marshal(msg.Body)
+	if !bytes.Equal(got, want) {
+		t.Errorf("Build() body = %s, want %s", got, want)
+	}
+
+	if msg.TransactionDomain != "SCRIPT" || msg.ECLVersion != Version {
+		t.Errorf("Build() attributes = %v %v", msg.TransactionDomain, msg.ECLVersion)
+	}
+	if msg.Header.MessageID == "" || msg.Header.SentTime.IsZero() {
+		t.Errorf("Build() header defaults not set: %+v", msg.Header)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(msg); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if _, err := NewDecoder(&buf).Decode(); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+}
+
+func TestRxBuilderDefaults(t *testing.T) {
+	code := "C48542"
+	msg, err := NewRxBuilder().
+		To("P", "6557744").
+		From("D", "6128890368017").
+		MessageID("msg-1").
+		SenderSoftware("Elation Health", "ElationEMR", "3.0").
+		Patient(HumanPatient{
+			Name:        Name{LastName: "Craigling", FirstName: "Jenny"},
+			Gender:      "F",
+			DateOfBirth: DateOfBirth{Date: Date{time.Date(1984, 9, 9, 0, 0, 0, 0, time.UTC)}},
+		}).
+		PatientMiddleName("Q").
+		Pharmacy(Pharmacy{
+			BusinessName:   "A+ Drugs",
+			Identification: ProviderIdentification{NCPDPID: "6557744"},
+			Address:        Address{AddressLine1: "1 Main St", City: "Columbus", StateProvince: "OH", PostalCode: "43215"},
+		}).
+		Prescriber(NonVeterinarian{
+			Identification: ProviderIdentification{NPI: "1234567890"},
+			Name:           Name{LastName: "Bless", FirstName: "Janine"},
+		}).
+		Medication(Medication{DrugDescription: "Ondansetron 8 mg Tab Disintegrating"}).
+		Quantity(15, "38", code).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	if msg.Header.MessageID != "msg-1" {
+		t.Errorf("MessageID = %v, want msg-1", msg.Header.MessageID)
+	}
+
+	p := msg.Body.NewRx.Patient.HumanPatient
+	if p.Name.MiddleName == nil || *p.Name.MiddleName != "Q" {
+		t.Errorf("MiddleName = %v, want Q", p.Name.MiddleName)
+	}
+
+	m := msg.Body.NewRx.MedicationPrescribed
+	if m.WrittenDate.Date == nil || m.WrittenDate.Date.Format("2006-01-02") != time.Now().UTC().Format("2006-01-02") {
+		t.Errorf("WrittenDate = %v, want today", m.WrittenDate.Date)
+	}
+	if *m.Quantity.QuantityUnitOfMeasure.Code != code {
+		t.Errorf("QuantityUnitOfMeasure = %v, want %v", *m.Quantity.QuantityUnitOfMeasure.Code, code)
+	}
+}
+
+func TestRxBuilderQuantityBeforeMedication(t *testing.T) {
+	sample := decodeFile(t, "testdata/sample-newrx.xml")
+	rx := sample.Body.NewRx
+
+	msg, err := NewRxBuilder().
+		To("P", "6557744").
+		From("D", "6128890368017").
+		SenderSoftware("Elation Health", "ElationEMR", "3.0").
+		Patient(rx.Patient.HumanPatient).
+		Pharmacy(rx.Pharmacy).
+		Prescriber(rx.Prescriber.NonVeterinarian).
+		Quantity(15, "38", "C48542").
+		Medication(Medication{DrugDescription: "Ondansetron 8 mg Tab Disintegrating"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	q := msg.Body.NewRx.MedicationPrescribed.Quantity
+	if q.Value != 15 || q.CodeListQualifier != "38" || *q.QuantityUnitOfMeasure.Code != "C48542" {
+		t.Errorf("Quantity = %+v, want 15 38 C48542", q)
+	}
+}
+
+func TestRxBuilderReuse(t *testing.T) {
+	sample := decodeFile(t, "testdata/sample-newrx.xml")
+	rx := sample.Body.NewRx
+
+	b := NewRxBuilder().
+		To("P", "6557744").
+		From("D", "6128890368017").
+		SenderSoftware("Elation Health", "ElationEMR", "3.0").
+		Patient(rx.Patient.HumanPatient).
+		Pharmacy(rx.Pharmacy).
+		Prescriber(rx.Prescriber.NonVeterinarian).
+		Medication(Medication{DrugDescription: "Ondansetron 8 mg Tab Disintegrating"}).
+		Quantity(15, "38", "C48542").
+		NumberOfRefills(1)
+
+	first, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	want, _ := json.Marshal(first)
+
+	second, err := b.To("P", "1234567").
+		Medication(Medication{DrugDescription: "Amoxicillin 500 mg Capsule"}).
+		Quantity(30, "38", "C48480").
+		NumberOfRefills(2).
+		Build()
+	if err != nil {
+		t.Fatalf("second Build() error = %v", err)
+	}
+
+	if got, _ := json.Marshal(first); !bytes.Equal(got, want) {
+		t.Errorf("first message changed by later builder calls:\n got %s\nwant %s", got, want)
+	}
+	if second == first || second.Body.NewRx == first.Body.NewRx {
+		t.Error("Build() returned the same message twice")
+	}
+	if second.Header.To.Value != "1234567" || *second.Body.NewRx.MedicationPrescribed.NumberOfRefills != 2 ||
+		*second.Body.NewRx.MedicationPrescribed.Quantity.QuantityUnitOfMeasure.Code != "C48480" {
+		t.Errorf("second message = %+v", second.Body.NewRx.MedicationPrescribed)
+	}
+}
+
+func TestRxBuilderValidation(t *testing.T) {
+	msg, err := NewRxBuilder().
+		To("P", "6557744").
+		From("D", "6128890368017").
+		Medication(Medication{DrugDescription: "Ondansetron 8 mg Tab Disintegrating"}).
+		Build()
+	if msg != nil {
+		t.Errorf("Build() = %v, want nil", msg)
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Build() error = %v, want ValidationErrors", err)
+	}
+
+	want := map[string]bool{
+		"Body/NewRx/Patient":    true,
+		"Body/NewRx/Pharmacy":   true,
+		"Body/NewRx/Prescriber": true,
+		"Body/NewRx/MedicationPrescribed/Quantity/CodeListQualifier":          true,
+		"Body/NewRx/MedicationPrescribed/Quantity/QuantityUnitOfMeasure/Code": true,
+	}
+	for _, e := range errs {
+		delete(want, e.Path)
+	}
+	if len(want) > 0 {
+		t.Errorf("Build() error = %v, missing %v", err, want)
+	}
+}
+
+func TestRxBuilderMissingIdentification(t *testing.T) {
+	sample := decodeFile(t, "testdata/sample-newrx.xml")
+	rx := sample.Body.NewRx
+
+	pharmacy := rx.Pharmacy
+	pharmacy.Identification = ProviderIdentification{}
+	prescriber := rx.Prescriber.NonVeterinarian
+	prescriber.Identification = ProviderIdentification{}
+
+	msg, err := NewRxBuilder().
+		To("P", "6557744").
+		From("D", "6128890368017").
+		Patient(rx.Patient.HumanPatient).
+		Pharmacy(pharmacy).
+		Prescriber(prescriber).
+		Medication(rx.MedicationPrescribed).
+		Build()
+	if msg != nil {
+		t.Errorf("Build() = %v, want nil", msg)
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Build() error = %v, want ValidationErrors", err)
+	}
+
+	want := map[string]bool{
+		"Header/SenderSoftware/SenderSoftwareDeveloper":        true,
+		"Header/SenderSoftware/SenderSoftwareProduct":          true,
+		"Header/SenderSoftware/SenderSoftwareVersionRelease":   true,
+		"Body/NewRx/Pharmacy/Identification":                   true,
+		"Body/NewRx/Prescriber/NonVeterinarian/Identification": true,
+	}
+	for _, e := range errs {
+		if !want[e.Path] {
+			t.Errorf("Build() unexpected violation %v", e)
+		}
+		delete(want, e.Path)
+	}
+	if len(want) > 0 {
+		t.Errorf("Build() error = %v, missing %v", err, want)
+	}
+}
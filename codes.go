@@ -0,0 +1,162 @@
+package ncpdp
+
+import "strings"
+
+// ReasonCodes maps the ReasonCode values carried in a Denied, Approved or
+// Validated Response to their NCPDP descriptions.
+var ReasonCodes = map[string]string{
+	"AA": "Patient unknown to the Provider",
+	"AB": "Patient never under Provider care",
+	"AC": "Patient no longer under Provider care",
+	"AD": "Patient has requested refill too soon",
+	"AE": "Medication never prescribed for the patient",
+	"AF": "Patient should contact Provider first",
+	"AG": "Fill/Refill not appropriate",
+	"AH": "Patient has picked up prescription",
+	"AJ": "Patient has picked up partial fill of prescription",
+	"AK": "Patient has not picked up prescription, drug returned to stock",
+	"AL": "Change not appropriate",
+	"AM": "Patient needs appointment",
+	"AN": "Prescriber not associated with this practice or location",
+	"AO": "No attempt will be made to obtain Prior Authorization",
+	"AP": "Request already responded to by other means (e.g. phone or fax)",
+	"AQ": "More Medication History Available",
+	"AR": "Unable to cancel prescription; prescription was transferred to another pharmacy",
+	"AS": "Qualified provider unavailable to respond",
+}
+
+func FindReasonCode(code string) string {
+	return ReasonCodes[code]
+}
+
+// Text describes the reason, preferring the descriptions of the known
+// ReasonCodes, joined by "; ", and falling back to the free text DenialReason
+// or Note.
+func (r *Reason) Text() string {
+	if r == nil {
+		return ""
+	}
+
+	var descriptions []string
+	for _, code := range r.ReasonCode {
+		if s := FindReasonCode(code); s != "" {
+			descriptions = append(descriptions, s)
+		}
+	}
+	if len(descriptions) > 0 {
+		return strings.Join(descriptions, "; ")
+	}
+
+	if r.DenialReason != nil {
+		return *r.DenialReason
+	}
+
+	if r.Note != nil {
+		return *r.Note
+	}
+
+	return ""
+}
+
+// Code values carried by Status and Error transactions.
+const (
+	StatusTransactionSuccessful  = "000"
+	StatusReceivedByReceiver     = "010"
+	ErrorCommunicationProblem    = "600"
+	ErrorReceiverUnableToProcess = "601"
+	ErrorReceiverSystemError     = "602"
+	ErrorTransactionRejected     = "900"
+)
+
+var StatusCodes = map[string]string{
+	StatusTransactionSuccessful:  "Transaction successful",
+	StatusReceivedByReceiver:     "Successfully received by ultimate receiver",
+	ErrorCommunicationProblem:    "Communication problem - try again later",
+	ErrorReceiverUnableToProcess: "Receiver unable to process",
+	ErrorReceiverSystemError:     "Receiver system error",
+	ErrorTransactionRejected:     "Transaction rejected",
+}
+
+// DescriptionCode values qualifying an Error code.
+const (
+	DescriptionGenericError     = "001"
+	DescriptionUnableToProcess  = "002"
+	DescriptionRequestTimedOut  = "008"
+	DescriptionUnableToIdentify = "210"
+	DescriptionDuplicateRequest = "220"
+
+	// DescriptionRequiredElementMissing reports a missing required element
+	// with ECL entry 210, Unable to identify based on information submitted.
+	DescriptionRequiredElementMissing = DescriptionUnableToIdentify
+
+	// DescriptionInvalidValue, DescriptionLengthExceeded,
+	// DescriptionUnknownElement and DescriptionChoiceViolation report schema
+	// violations with ECL entry 001, Generic error; the ECL has no entry
+	// specific to them.
+	DescriptionInvalidValue    = DescriptionGenericError
+	DescriptionLengthExceeded  = DescriptionGenericError
+	DescriptionUnknownElement  = DescriptionGenericError
+	DescriptionChoiceViolation = DescriptionGenericError
+)
+
+var DescriptionCodes = map[string]string{
+	DescriptionGenericError:     "Generic error",
+	DescriptionUnableToProcess:  "Unable to process transaction. Please resubmit.",
+	DescriptionRequestTimedOut:  "Request timed out before response could be received",
+	DescriptionUnableToIdentify: "Unable to identify based on information submitted",
+	DescriptionDuplicateRequest: "Transaction is a duplicate",
+}
+
+const (
+	FillStatusDispensed          = "Dispensed"
+	FillStatusPartiallyDispensed = "PartiallyDispensed"
+	FillStatusNotDispensed       = "NotDispensed"
+	FillStatusTransferred        = "Transferred"
+)
+
+// Status returns the name of the fill status that is present, or "" when
+// none is set.
+func (f FillStatus) Status() string {
+	switch {
+	case f.Dispensed != nil:
+		return FillStatusDispensed
+	case f.PartiallyDispensed != nil:
+		return FillStatusPartiallyDispensed
+	case f.NotDispensed != nil:
+		return FillStatusNotDispensed
+	case f.Transferred != nil:
+		return FillStatusTransferred
+	}
+
+	return ""
+}
+
+// RxFillIndicator values for Medication.RxFillIndicator.
+const (
+	RxFillAllFillStatuses                   = "All Fill Statuses"
+	RxFillAllFillStatusesExceptTransferred  = "All Fill Statuses Except Transferred"
+	RxFillDispensedAndPartiallyDispensed    = "Dispensed And Partially Dispensed"
+	RxFillPartiallyDispensedAndNotDispensed = "Partially Dispensed And Not Dispensed"
+	RxFillNotDispensedAndTransferred        = "Not Dispensed And Transferred"
+	RxFillPartiallyDispensed                = "Partially Dispensed"
+	RxFillNotDispensed                      = "Not Dispensed"
+	RxFillTransferred                       = "Transferred"
+	RxFillCancelAllFillStatuses             = "Cancel All Fill Statuses"
+)
+
+// PatientEnrollmentStatus returns the REMS patient enrollment status from
+// whichever response branch is present.
+func (s REMSStatus) PatientEnrollmentStatus() string {
+	switch {
+	case s.Open != nil:
+		return s.Open.PatientEnrollmentStatus
+	case s.Approved != nil:
+		return s.Approved.PatientEnrollmentStatus
+	case s.Denied != nil:
+		return s.Denied.PatientEnrollmentStatus
+	case s.Closed != nil:
+		return s.Closed.PatientEnrollmentStatus
+	}
+
+	return ""
+}
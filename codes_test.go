@@ -0,0 +1,51 @@
+package ncpdp
+
+import "testing"
+
+func TestReasonText(t *testing.T) {
+	text := "Already filled"
+
+	tests := []struct {
+		name   string
+		reason *Reason
+		want   string
+	}{
+		{
+			name:   "known code",
+			reason: &Reason{ReasonCode: []string{"AR"}, DenialReason: &text},
+			want:   "Unable to cancel prescription; prescription was transferred to another pharmacy",
+		},
+		{
+			name:   "several codes",
+			reason: &Reason{ReasonCode: []string{"AL", "ZZ", "AM"}},
+			want:   "Change not appropriate; Patient needs appointment",
+		},
+		{
+			name:   "unknown code falls back to denial reason",
+			reason: &Reason{ReasonCode: []string{"ZZ"}, DenialReason: &text},
+			want:   text,
+		},
+		{
+			name:   "note only",
+			reason: &Reason{Note: &text},
+			want:   text,
+		},
+		{
+			name:   "empty reason",
+			reason: &Reason{},
+			want:   "",
+		},
+		{
+			name: "nil reason",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.reason.Text(); got != tt.want {
+				t.Errorf("Text() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package ncpdp
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+)
+
+const (
+	Namespace = "http://www.ncpdp.org/schema/SCRIPT"
+	Version   = "20170715"
+)
+
+type Encoder struct {
+	w      io.Writer
+	prefix string
+	indent string
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+func (e *Encoder) Indent(prefix, indent string) {
+	e.prefix = prefix
+	e.indent = indent
+}
+
+// Encode writes msg as a SCRIPT 2017071 document. Any version attribute left
+// empty on msg is filled with Version; msg itself is not modified.
+func (e *Encoder) Encode(msg *Message) error {
+	if msg == nil {
+		return errors.New("ncpdp: cannot encode nil message")
+	}
+
+	m := *msg
+	for _, v := range []*string{
+		&m.DatatypesVersion,
+		&m.TransportVersion,
+		&m.TransactionVersion,
+		&m.StructuresVersion,
+		&m.ECLVersion,
+	} {
+		if *v == "" {
+			*v = Version
+		}
+	}
+	if m.TransactionDomain == "" {
+		m.TransactionDomain = "SCRIPT"
+	}
+
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(e.w)
+	enc.Indent(e.prefix, e.indent)
+
+	start := xml.StartElement{
+		Name: xml.Name{Local: "Message"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: Namespace}},
+	}
+	if err := enc.EncodeElement(m, start); err != nil {
+		return err
+	}
+
+	return enc.Flush()
+}
+
+func (d *Decoder) ToXml() ([]byte, error) {
+	err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := NewEncoder(buf).Encode(d.msg); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeOptional skips elements whose Go value is zero so that optional
+// elements held by value behave like pointers with omitempty.
+func encodeOptional(e *xml.Encoder, start xml.StartElement, v interface{}) error {
+	if reflect.ValueOf(v).IsZero() {
+		return nil
+	}
+
+	return e.EncodeElement(v, start)
+}
+
+func (s Security) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type security Security
+	return encodeOptional(e, start, security(s))
+}
+
+func (a Address) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type address Address
+	return encodeOptional(e, start, address(a))
+}
+
+// Name, CommunicationNumbers and ProviderIdentification keep their field
+// order, and so their JSON key order, from before the Encoder existed; their
+// elements are written here in schema order.
+
+func (n Name) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		LastName   string  `xml:"LastName"`
+		FirstName  string  `xml:"FirstName"`
+		MiddleName *string `xml:"MiddleName"`
+		Suffix     string  `xml:"Suffix,omitempty"`
+		Prefix     string  `xml:"Prefix,omitempty"`
+	}{n.LastName, n.FirstName, n.MiddleName, n.Suffix, n.Prefix}, start)
+}
+
+func (c CommunicationNumbers) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeOptional(e, start, struct {
+		XMLName          xml.Name
+		PrimaryTelephone *Telephone `xml:"PrimaryTelephone"`
+		ElectronicMail   string     `xml:"ElectronicMail,omitempty"`
+		Fax              *Fax       `xml:"Fax"`
+		HomeTelephone    *Telephone `xml:"HomeTelephone"`
+		WorkTelephone    *Telephone `xml:"WorkTelephone"`
+		OtherTelephone   *Telephone `xml:"OtherTelephone"`
+	}{c.XMLName, c.PrimaryTelephone, c.ElectronicMail, c.Fax, c.HomeTelephone, c.WorkTelephone, c.OtherTelephone})
+}
+
+func (p ProviderIdentification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		NCPDPID            string `xml:"NCPDPID,omitempty"`
+		StateLicenseNumber string `xml:"StateLicenseNumber,omitempty"`
+		DEANumber          string `xml:"DEANumber,omitempty"`
+		NPI                string `xml:"NPI,omitempty"`
+	}{p.NCPDPID, p.StateLicenseNumber, p.DEANumber, p.NPI}, start)
+}
+
+func (d DrugCoded) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type drugCoded DrugCoded
+	return encodeOptional(e, start, drugCoded(d))
+}
+
+func (c Coded) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type coded Coded
+	return encodeOptional(e, start, coded(c))
+}
+
+func (u UnitOfMeasure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type unitOfMeasure UnitOfMeasure
+	return encodeOptional(e, start, unitOfMeasure(u))
+}
+
+func (s Sig) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type sig Sig
+	return encodeOptional(e, start, sig(s))
+}
+
+func (w WrittenDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type writtenDate WrittenDate
+	return encodeOptional(e, start, writtenDate(w))
+}
+
+func (d EffectiveDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type effectiveDate EffectiveDate
+	return encodeOptional(e, start, effectiveDate(d))
+}
+
+func (h HumanPatient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type humanPatient HumanPatient
+	return encodeOptional(e, start, humanPatient(h))
+}
+
+func (n NonVeterinarian) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type nonVeterinarian NonVeterinarian
+	return encodeOptional(e, start, nonVeterinarian(n))
+}
@@ -0,0 +1,369 @@
+package ncpdp
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{
+			name: "newrx",
+			file: "testdata/sample-newrx.xml",
+		},
+		{
+			name: "newrx quantity float",
+			file: "testdata/sample-newrx-qty-float.xml",
+		},
+		{
+			name: "rx change request",
+			file: "testdata/sample-rxchangerequest.xml",
+		},
+		{
+			name: "rx change response",
+			file: "testdata/sample-rxchangeresponse.xml",
+		},
+		{
+			name: "rx change response with several reason codes",
+			file: "testdata/sample-rxchangeresponse-denied.xml",
+		},
+		{
+			name: "cancel rx response",
+			file: "testdata/sample-cancelrxresponse.xml",
+		},
+		{
+			name: "rx fill",
+			file: "testdata/sample-rxfill.xml",
+		},
+		{
+			name: "rx fill indicator change",
+			file: "testdata/sample-rxfillindicatorchange.xml",
+		},
+		{
+			name: "rx history request",
+			file: "testdata/sample-rxhistoryrequest.xml",
+		},
+		{
+			name: "rx history response",
+			file: "testdata/sample-rxhistoryresponse.xml",
+		},
+		{
+			name: "rx transfer request",
+			file: "testdata/sample-rxtransferrequest.xml",
+		},
+		{
+			name: "rx transfer response",
+			file: "testdata/sample-rxtransferresponse.xml",
+		},
+		{
+			name: "rx transfer confirm",
+			file: "testdata/sample-rxtransferconfirm.xml",
+		},
+		{
+			name: "pa initiation request",
+			file: "testdata/sample-painitiationrequest.xml",
+		},
+		{
+			name: "pa initiation response",
+			file: "testdata/sample-painitiationresponse.xml",
+		},
+		{
+			name: "pa request",
+			file: "testdata/sample-parequest.xml",
+		},
+		{
+			name: "pa response",
+			file: "testdata/sample-paresponse.xml",
+		},
+		{
+			name: "pa appeal request",
+			file: "testdata/sample-paappealrequest.xml",
+		},
+		{
+			name: "pa appeal response",
+			file: "testdata/sample-paappealresponse.xml",
+		},
+		{
+			name: "pa cancel request",
+			file: "testdata/sample-pacancelrequest.xml",
+		},
+		{
+			name: "pa cancel response",
+			file: "testdata/sample-pacancelresponse.xml",
+		},
+		{
+			name: "resupply",
+			file: "testdata/sample-resupply.xml",
+		},
+		{
+			name: "drug administration",
+			file: "testdata/sample-drugadministration.xml",
+		},
+		{
+			name: "census",
+			file: "testdata/sample-census.xml",
+		},
+		{
+			name: "rems initiation request",
+			file: "testdata/sample-remsinitiationrequest.xml",
+		},
+		{
+			name: "rems initiation response",
+			file: "testdata/sample-remsinitiationresponse.xml",
+		},
+		{
+			name: "rems request",
+			file: "testdata/sample-remsrequest.xml",
+		},
+		{
+			name: "rems response",
+			file: "testdata/sample-remsresponse.xml",
+		},
+		{
+			name: "new rx request",
+			file: "testdata/sample-newrxrequest.xml",
+		},
+		{
+			name: "new rx response denied",
+			file: "testdata/sample-newrxresponsedenied.xml",
+		},
+		{
+			name: "clinical info request",
+			file: "testdata/sample-clinicalinforequest.xml",
+		},
+		{
+			name: "clinical info response",
+			file: "testdata/sample-clinicalinforesponse.xml",
+		},
+		{
+			name: "newrx veterinary",
+			file: "testdata/sample-newrx-veterinary.xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			msg, err := NewDecoder(file).Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			first := new(bytes.Buffer)
+			if err := NewEncoder(first).Encode(msg); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			decoded, err := NewDecoder(bytes.NewReader(first.Bytes())).Decode()
+			if err != nil {
+				t.Fatalf("Decode() of encoded message error = %v", err)
+			}
+
+			second := new(bytes.Buffer)
+			if err := NewEncoder(second).Encode(decoded); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			if first.String() != second.String() {
+				t.Errorf("Encode() round trip mismatch\nfirst:  %s\nsecond: %s", first, second)
+			}
+
+			want, _ := json.Marshal(msg)
+			got, _ := json.Marshal(decoded)
+			if !bytes.Equal(got, want) {
+				t.Errorf("Encode() decoded = %s, want %s", got, want)
+			}
+
+			source, err := os.ReadFile(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantValues := elementValues(t, source)
+			for v, n := range elementValues(t, first.Bytes()) {
+				wantValues[v] -= n
+			}
+			for v, n := range wantValues {
+				if n != 0 {
+					t.Errorf("Encode() count of %s differs from %s by %d", v, tt.file, -n)
+				}
+			}
+		})
+	}
+}
+
+// elementValues counts the leaf element values and attributes of an XML
+// document by path, e.g. "Message/Header/To=6557744" or
+// "Message/Header/To@Qualifier=P". Namespace declarations are left out.
+func elementValues(t *testing.T, data []byte) map[string]int {
+	t.Helper()
+
+	values := map[string]int{}
+	var path []string
+	var text strings.Builder
+	leaf := false
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return values
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			path = append(path, tok.Name.Local)
+			for _, a := range tok.Attr {
+				if a.Name.Local != "xmlns" && a.Name.Space != "xmlns" {
+					values[strings.Join(path, "/")+"@"+a.Name.Local+"="+a.Value]++
+				}
+			}
+			text.Reset()
+			leaf = true
+		case xml.CharData:
+			text.Write(tok)
+		case xml.EndElement:
+			if leaf {
+				values[strings.Join(path, "/")+"="+strings.TrimSpace(text.String())]++
+			}
+			leaf = false
+			path = path[:len(path)-1]
+		}
+	}
+}
+
+func TestEncodeDefaults(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := NewEncoder(buf).Encode(&Message{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`xmlns="` + Namespace + `"`,
+		`DatatypesVersion="20170715"`,
+		`TransactionDomain="SCRIPT"`,
+		`<SentTime>`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Encode() = %s, missing %s", buf, want)
+		}
+	}
+
+	for _, unwanted := range []string{"<Security>", "<Date>", "<RelatesToMessageID>"} {
+		if strings.Contains(buf.String(), unwanted) {
+			t.Errorf("Encode() = %s, unexpected %s", buf, unwanted)
+		}
+	}
+
+	if err := NewEncoder(buf).Encode(nil); err == nil {
+		t.Error("Encode(nil) error = nil, want error")
+	}
+}
+
+func TestDecoderRepeatedCalls(t *testing.T) {
+	file, err := os.Open("testdata/sample-rxhistoryresponse.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	d := NewDecoder(file)
+	msg, err := d.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := len(msg.Body.RxHistoryResponse.MedicationDispensed)
+
+	jsonData, err := d.ToJson()
+	if err != nil {
+		t.Fatalf("ToJson() error = %v", err)
+	}
+	xmlData, err := d.ToXml()
+	if err != nil {
+		t.Fatalf("ToXml() error = %v", err)
+	}
+	again, err := d.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromJson, err := NewJsonDecoder(bytes.NewReader(jsonData)).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromXml, err := NewDecoder(bytes.NewReader(xmlData)).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, m := range map[string]*Message{"Decode": again, "ToJson": fromJson, "ToXml": fromXml} {
+		if got := len(m.Body.RxHistoryResponse.MedicationDispensed); got != want {
+			t.Errorf("%s MedicationDispensed = %d entries, want %d", name, got, want)
+		}
+	}
+}
+
+func TestEncodeSameElements(t *testing.T) {
+	for _, name := range []string{
+		"testdata/sample-painitiationresponse.xml",
+		"testdata/sample-parequest.xml",
+	} {
+		t.Run(name, func(t *testing.T) {
+			in, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := NewDecoder(bytes.NewReader(in)).ToXml()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, got := countElements(t, in), countElements(t, out)
+			for el, n := range got {
+				if n != want[el] {
+					t.Errorf("Encode() %s elements = %d, want %d", el, n, want[el])
+				}
+			}
+			for el, n := range want {
+				if _, ok := got[el]; !ok {
+					t.Errorf("Encode() %s elements = 0, want %d", el, n)
+				}
+			}
+		})
+	}
+}
+
+func countElements(t *testing.T, data []byte) map[string]int {
+	t.Helper()
+
+	counts := map[string]int{}
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return counts
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+}
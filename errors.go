@@ -0,0 +1,235 @@
+package ncpdp
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DecodeError reports the element of an XML document that failed to decode.
+type DecodeError struct {
+	Path            string
+	Line            int
+	Column          int
+	Value           string
+	Code            string
+	DescriptionCode string
+	Err             error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("ncpdp: %s (line %d, column %d): %v", e.Path, e.Line, e.Column, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+type ValidationError struct {
+	Path            string
+	Line            int
+	Column          int
+	Value           string
+	Message         string
+	Code            string
+	DescriptionCode string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s (line %d, column %d): %s", e.Path, e.Line, e.Column, e.Message)
+	}
+
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors collects every violation found in a message.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	s := make([]string, len(e))
+	for i := range e {
+		s[i] = e[i].Error()
+	}
+
+	return strings.Join(s, "; ")
+}
+
+// ErrorCodes returns the Error Code and DescriptionCode to report back to
+// the sender of a message that failed with err.
+func ErrorCodes(err error) (code, descriptionCode string) {
+	var de *DecodeError
+	if errors.As(err, &de) {
+		return de.Code, de.DescriptionCode
+	}
+
+	var errs ValidationErrors
+	if errors.As(err, &errs) && len(errs) > 0 {
+		return errs[0].Code, errs[0].DescriptionCode
+	}
+
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ve.Code, ve.DescriptionCode
+	}
+
+	return ErrorReceiverSystemError, DescriptionGenericError
+}
+
+func newDecodeError(buf []byte, offset int64, err error) *DecodeError {
+	e := &DecodeError{
+		Code:            ErrorTransactionRejected,
+		DescriptionCode: DescriptionGenericError,
+		Err:             err,
+	}
+
+	var start int64
+	e.Path, start, e.Value = locate(buf, offset)
+
+	var syntaxErr *xml.SyntaxError
+	var parseErr *time.ParseError
+	var numErr *strconv.NumError
+	switch {
+	case errors.As(err, &syntaxErr):
+		e.Code, e.DescriptionCode = ErrorReceiverUnableToProcess, DescriptionUnableToProcess
+		e.Value = ""
+		start = offset
+	case errors.As(err, &parseErr):
+		e.Value = parseErr.Value
+	case errors.As(err, &numErr):
+		e.Value = numErr.Num
+	}
+
+	e.Line, e.Column = position(buf, start)
+	return e
+}
+
+// locate finds the innermost element being decoded when the decoder stopped
+// at offset, returning its path, start offset and character data.
+func locate(buf []byte, offset int64) (string, int64, string) {
+	type open struct {
+		name  string
+		start int64
+		text  []byte
+	}
+
+	var stack []*open
+	result := func() (string, int64, string) {
+		if len(stack) == 0 {
+			return "Message", 0, ""
+		}
+
+		names := make([]string, 0, len(stack))
+		for _, o := range stack[1:] {
+			names = append(names, o.name)
+		}
+		path := strings.Join(names, "/")
+		if path == "" {
+			path = "Message"
+		}
+
+		top := stack[len(stack)-1]
+		return path, top.start, strings.TrimSpace(string(top.text))
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(buf))
+	for {
+		before := dec.InputOffset()
+		if before >= offset {
+			return result()
+		}
+
+		tok, err := dec.Token()
+		if err != nil {
+			return result()
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, &open{name: tok.Name.Local, start: before})
+		case xml.CharData:
+			if len(stack) > 0 {
+				top := stack[len(stack)-1]
+				top.text = append(top.text, tok...)
+			}
+		case xml.EndElement:
+			if dec.InputOffset() >= offset {
+				return result()
+			}
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
+
+// position converts a byte offset into a 1-based line and column.
+func position(buf []byte, offset int64) (int, int) {
+	if offset > int64(len(buf)) {
+		offset = int64(len(buf))
+	}
+
+	b := buf[:offset]
+	return bytes.Count(b, []byte("\n")) + 1, len(b) - bytes.LastIndexByte(b, '\n')
+}
+
+// elementPositions indexes the start offset of every element in an XML
+// document by its path, with each path segment carrying its 1-based index.
+func elementPositions(buf []byte) map[string]int64 {
+	type open struct {
+		path   string
+		counts map[string]int
+	}
+
+	positions := map[string]int64{}
+	stack := []*open{{counts: map[string]int{}}}
+
+	dec := xml.NewDecoder(bytes.NewReader(buf))
+	for {
+		before := dec.InputOffset()
+		tok, err := dec.Token()
+		if err != nil {
+			return positions
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			parent := stack[len(stack)-1]
+			path := ""
+			if len(stack) > 1 {
+				parent.counts[tok.Name.Local]++
+				path = join(parent.path, fmt.Sprintf("%s[%d]", tok.Name.Local, parent.counts[tok.Name.Local]))
+			}
+			positions[path] = before
+			stack = append(stack, &open{path: path, counts: map[string]int{}})
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
+
+// findPosition returns the offset of the element at path, or of its nearest
+// ancestor present in the document when the element itself is missing.
+func findPosition(positions map[string]int64, path string) int64 {
+	var segments []string
+	for _, s := range strings.Split(path, "/") {
+		if !strings.HasSuffix(s, "]") {
+			s += "[1]"
+		}
+		segments = append(segments, s)
+	}
+
+	for n := len(segments); n > 0; n-- {
+		if offset, ok := positions[strings.Join(segments[:n], "/")]; ok {
+			return offset
+		}
+	}
+
+	return positions[""]
+}
@@ -0,0 +1,197 @@
+package ncpdp
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    string
+		path   string
+		line   int
+		column int
+		value  string
+		code   string
+	}{
+		{
+			name:   "date parse error",
+			msg:    `<Message><Body><NewRx><Patient><HumanPatient><DateOfBirth><Date>20219-01-01</Date></DateOfBirth></HumanPatient></Patient></NewRx></Body></Message>`,
+			path:   "Body/NewRx/Patient/HumanPatient/DateOfBirth/Date",
+			line:   1,
+			column: 59,
+			value:  "20219-01-01",
+			code:   ErrorTransactionRejected,
+		},
+		{
+			name: "number parse error",
+			msg: "<Message>\n  <Body>\n    <NewRx>\n      <MedicationPrescribed>\n" +
+				"        <NumberOfRefills>two</NumberOfRefills>\n" +
+				"      </MedicationPrescribed>\n    </NewRx>\n  </Body>\n</Message>",
+			path:   "Body/NewRx/MedicationPrescribed/NumberOfRefills",
+			line:   5,
+			column: 9,
+			value:  "two",
+			code:   ErrorTransactionRejected,
+		},
+		{
+			name:   "syntax error",
+			msg:    "<Message>\n<Header>\n<MessageID>1</MessageID>\n<To></From>\n</Header>\n</Message>",
+			path:   "Header/To",
+			line:   4,
+			column: 12,
+			code:   ErrorReceiverUnableToProcess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDecoder(strings.NewReader(tt.msg)).Decode()
+
+			var de *DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("Decode() error = %v, want *DecodeError", err)
+			}
+
+			if de.Path != tt.path {
+				t.Errorf("Path = %v, want %v", de.Path, tt.path)
+			}
+			if de.Line != tt.line || de.Column != tt.column {
+				t.Errorf("position = %d:%d, want %d:%d", de.Line, de.Column, tt.line, tt.column)
+			}
+			if de.Value != tt.value {
+				t.Errorf("Value = %v, want %v", de.Value, tt.value)
+			}
+			if code, _ := ErrorCodes(err); code != tt.code {
+				t.Errorf("ErrorCodes() code = %v, want %v", code, tt.code)
+			}
+		})
+	}
+
+	_, err := NewDecoder(strings.NewReader(tests[0].msg)).Decode()
+	var parseErr *time.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("Decode() error = %v, want wrapped *time.ParseError", err)
+	}
+}
+
+func TestDecoderValidate(t *testing.T) {
+	buf, err := os.ReadFile("testdata/sample-newrx.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := strings.Replace(string(buf), "<LastName>Jenny</LastName>", "<LastName>"+strings.Repeat("J", 40)+"</LastName>", 1)
+
+	d := NewDecoder(strings.NewReader(doc))
+	if _, err := d.Decode(); err != nil {
+		t.Fatal(err)
+	}
+
+	err = d.Validate()
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+	}
+
+	if len(errs) != 1 {
+		t.Fatalf("Validate() = %v, want 1 violation", err)
+	}
+
+	e := errs[0]
+	if e.Path != "Body/NewRx/Patient/HumanPatient/Name/LastName" || e.Value != strings.Repeat("J", 40) {
+		t.Errorf("violation = %+v", e)
+	}
+	if e.Line != 30 || e.Column != 25 {
+		t.Errorf("position = %d:%d, want 30:25", e.Line, e.Column)
+	}
+	if code, desc := ErrorCodes(err); code != ErrorTransactionRejected || desc != DescriptionGenericError {
+		t.Errorf("ErrorCodes() = %v, %v, want %v, %v", code, desc, ErrorTransactionRejected, DescriptionGenericError)
+	}
+}
+
+func TestValidationDescriptionCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(msg *Message)
+		strict string
+		want   string
+	}{
+		{
+			name: "missing required element",
+			mutate: func(msg *Message) {
+				msg.Header.MessageID = ""
+			},
+			want: DescriptionUnableToIdentify,
+		},
+		{
+			name: "invalid value",
+			mutate: func(msg *Message) {
+				msg.Body.NewRx.Patient.HumanPatient.Gender = "X"
+			},
+			want: DescriptionGenericError,
+		},
+		{
+			name: "length exceeded",
+			mutate: func(msg *Message) {
+				msg.Body.NewRx.MedicationPrescribed.Note = strings.Repeat("N", 211)
+			},
+			want: DescriptionGenericError,
+		},
+		{
+			name: "choice violation",
+			mutate: func(msg *Message) {
+				msg.Body.Status = &Coded{Code: StatusTransactionSuccessful}
+			},
+			want: DescriptionGenericError,
+		},
+		{
+			name:   "unknown element",
+			strict: "<Unknown>value</Unknown>",
+			want:   DescriptionGenericError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.strict != "" {
+				buf, readErr := os.ReadFile("testdata/sample-newrx.xml")
+				if readErr != nil {
+					t.Fatal(readErr)
+				}
+				doc := strings.Replace(string(buf), "</Header>", tt.strict+"</Header>", 1)
+
+				d := NewDecoder(strings.NewReader(doc))
+				d.DisallowUnknownElements()
+				_, err = d.Decode()
+			} else {
+				msg := decodeFile(t, "testdata/sample-newrx.xml")
+				tt.mutate(msg)
+				err = Validate(msg)
+			}
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) || len(errs) != 1 {
+				t.Fatalf("error = %v, want 1 violation", err)
+			}
+			if code, desc := ErrorCodes(err); code != ErrorTransactionRejected || desc != tt.want {
+				t.Errorf("ErrorCodes() = %v, %v, want %v, %v", code, desc, ErrorTransactionRejected, tt.want)
+			}
+			if DescriptionCodes[tt.want] == "" {
+				t.Errorf("DescriptionCodes[%v] is empty", tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	code, desc := ErrorCodes(errors.New("boom"))
+	if code != ErrorReceiverSystemError || desc != DescriptionGenericError {
+		t.Errorf("ErrorCodes() = %v, %v, want %v, %v", code, desc, ErrorReceiverSystemError, DescriptionGenericError)
+	}
+}
@@ -0,0 +1,147 @@
+// Command gentermcodes generates typed NCIt code constants for each subset of
+// NCPDPTerminology.txt.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+type subset struct {
+	code     string
+	typeName string
+	prefix   string
+	constant string
+	terms    string
+}
+
+// subsets lists the generated types in output order.
+var subsets = []subset{
+	{code: "C89507", typeName: "DEAScheduleCode", prefix: "DEASchedule", constant: "SubsetDEASchedule", terms: "deaScheduleTerms"},
+	{code: "C121847", typeName: "DoseUnitCode", prefix: "DoseUnit", constant: "SubsetDoseUnitOfMeasure", terms: "doseUnitTerms"},
+	{code: "C91101", typeName: "MeasurementUnitCode", prefix: "MeasurementUnit", constant: "SubsetMeasurementUnitCode", terms: "measurementUnitTerms"},
+	{code: "C89510", typeName: "QuantityUnitCode", prefix: "QuantityUnit", constant: "SubsetQuantityUnitOfMeasure", terms: "quantityUnitTerms"},
+	{code: "C89508", typeName: "StrengthFormCode", prefix: "StrengthForm", constant: "SubsetStrengthForm", terms: "strengthFormTerms"},
+	{code: "C89509", typeName: "StrengthUnitCode", prefix: "StrengthUnit", constant: "SubsetStrengthUnitOfMeasure", terms: "strengthUnitTerms"},
+}
+
+type entry struct {
+	name string
+	code string
+	term string
+}
+
+func main() {
+	in := flag.String("in", "NCPDPTerminology.txt", "terminology file")
+	out := flag.String("out", "terminology_codes.go", "generated file")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	src, err := generate(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(r io.Reader) ([]byte, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = '\t'
+	cr.FieldsPerRecord = 7
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string][]entry{}
+	names := map[string]bool{}
+	for _, rec := range records[1:] {
+		subsetCode, code, term := rec[0], rec[2], rec[3]
+
+		var s *subset
+		for i := range subsets {
+			if subsets[i].code == subsetCode {
+				s = &subsets[i]
+			}
+		}
+		if s == nil {
+			return nil, fmt.Errorf("unknown subset %s", subsetCode)
+		}
+
+		name := s.prefix + identifier(term)
+		if names[name] {
+			name += code
+		}
+		names[name] = true
+
+		entries[subsetCode] = append(entries[subsetCode], entry{name: name, code: code, term: term})
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gentermcodes from NCPDPTerminology.txt; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package ncpdp")
+
+	for _, s := range subsets {
+		fmt.Fprintln(&buf)
+		fmt.Fprintf(&buf, "// %s is an NCIt code from the %s terminology subset.\n", s.typeName, strings.TrimPrefix(s.constant, "Subset"))
+		fmt.Fprintf(&buf, "type %s string\n\n", s.typeName)
+
+		fmt.Fprintln(&buf, "const (")
+		for _, e := range entries[s.code] {
+			fmt.Fprintf(&buf, "\t%s %s = %q\n", e.name, s.typeName, e.code)
+		}
+		fmt.Fprintln(&buf, ")")
+		fmt.Fprintln(&buf)
+
+		terms := s.terms
+		fmt.Fprintf(&buf, "var %s = map[%s]string{\n", terms, s.typeName)
+		for _, e := range entries[s.code] {
+			fmt.Fprintf(&buf, "\t%s: %q,\n", e.name, e.term)
+		}
+		fmt.Fprintln(&buf, "}")
+		fmt.Fprintln(&buf)
+
+		fmt.Fprintln(&buf, "// String returns the NCPDP preferred term, or the code itself when it is")
+		fmt.Fprintln(&buf, "// not in the subset.")
+		fmt.Fprintf(&buf, "func (c %s) String() string {\n\tif term, ok := %s[c]; ok {\n\t\treturn term\n\t}\n\n\treturn string(c)\n}\n\n", s.typeName, terms)
+		fmt.Fprintf(&buf, "func (c %s) Valid() bool {\n\t_, ok := %s[c]\n\treturn ok\n}\n\n", s.typeName, terms)
+		fmt.Fprintf(&buf, "func (c %s) Subset() string {\n\treturn %s\n}\n", s.typeName, s.constant)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// identifier converts a preferred term such as "21 Day Tablet" or "mg/ml"
+// into an exported Go identifier fragment.
+func identifier(term string) string {
+	term = strings.ReplaceAll(term, "%", " Percent ")
+
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(term, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedCodesUpToDate(t *testing.T) {
+	f, err := os.Open("../../NCPDPTerminology.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	want, err := generate(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile("../../terminology_codes.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("terminology_codes.go is stale, run go generate")
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{term: "Tablet", want: "Tablet"},
+		{term: "21 Day Tablet", want: "21DayTablet"},
+		{term: "Pre-filled Pen Syringe", want: "PreFilledPenSyringe"},
+		{term: "mg/ml", want: "MgMl"},
+		{term: "%", want: "Percent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			if got := identifier(tt.term); got != tt.want {
+				t.Errorf("identifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateUnknownSubset(t *testing.T) {
+	in := "h1\th2\th3\th4\th5\th6\th7\nC1\tOther\tC2\tTerm\t\tTerm\tDefinition\n"
+	if _, err := generate(bytes.NewBufferString(in)); err == nil {
+		t.Error("generate() error = nil, want unknown subset error")
+	}
+}
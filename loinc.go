@@ -0,0 +1,612 @@
+package ncpdp
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNoLoincData is returned when LOINC data is requested from the embedded
+// file but the package was built without the ncpdp_loinc tag.
+var ErrNoLoincData = errors.New("ncpdp: no embedded LOINC data")
+
+// LoincIndex is a read-only index over LOINC records by LOINC_NUM,
+// COMPONENT, CLASS and SHORTNAME. It is safe for concurrent use.
+type LoincIndex struct {
+	records     []*Loinc
+	byNum       map[string]*Loinc
+	byComponent map[string][]*Loinc
+	byClass     map[string][]*Loinc
+	byShortName map[string][]*Loinc
+	version     string
+}
+
+type loincOptions struct {
+	columns map[string]bool
+	classes map[string]bool
+	version string
+}
+
+type LoincOption func(*loincOptions)
+
+// WithLoincColumns loads only the named CSV columns, e.g. "COMPONENT" or
+// "EXAMPLE_UCUM_UNITS". LOINC_NUM is always loaded.
+func WithLoincColumns(columns ...string) LoincOption {
+	return func(o *loincOptions) {
+		if o.columns == nil {
+			o.columns = map[string]bool{"LOINC_NUM": true}
+		}
+		for _, c := range columns {
+			o.columns[c] = true
+		}
+	}
+}
+
+// WithLoincClasses loads only records whose CLASS is one of classes.
+func WithLoincClasses(classes ...string) LoincOption {
+	return func(o *loincOptions) {
+		if o.classes == nil {
+			o.classes = map[string]bool{}
+		}
+		for _, c := range classes {
+			o.classes[c] = true
+		}
+	}
+}
+
+// WithLoincVersion records the LOINC release being loaded, overriding the
+// release derived from the VersionLastChanged column.
+func WithLoincVersion(version string) LoincOption {
+	return func(o *loincOptions) {
+		o.version = version
+	}
+}
+
+// loincColumns maps CSV column names to Loinc field indexes.
+var loincColumns = func() map[string]int {
+	columns := map[string]int{}
+	t := reflect.TypeOf(Loinc{})
+	for i := 0; i < t.NumField(); i++ {
+		if name := t.Field(i).Tag.Get("csv"); name != "" {
+			columns[name] = i
+		}
+	}
+	return columns
+}()
+
+// internedLoincColumns have few distinct values and share their strings.
+var internedLoincColumns = map[string]bool{
+	"PROPERTY":             true,
+	"TIME_ASPCT":           true,
+	"SYSTEM":               true,
+	"SCALE_TYP":            true,
+	"METHOD_TYP":           true,
+	"CLASS":                true,
+	"VersionLastChanged":   true,
+	"CHNG_TYPE":            true,
+	"STATUS":               true,
+	"CLASSTYPE":            true,
+	"UNITSREQUIRED":        true,
+	"ORDER_OBS":            true,
+	"VersionFirstReleased": true,
+}
+
+func newLoincIndex() *LoincIndex {
+	return &LoincIndex{
+		byNum:       map[string]*Loinc{},
+		byComponent: map[string][]*Loinc{},
+		byClass:     map[string][]*Loinc{},
+		byShortName: map[string][]*Loinc{},
+	}
+}
+
+func (idx *LoincIndex) add(l *Loinc) {
+	idx.records = append(idx.records, l)
+	idx.byNum[l.LoincNum] = l
+	if l.Component != "" {
+		key := strings.ToLower(l.Component)
+		idx.byComponent[key] = append(idx.byComponent[key], l)
+	}
+	if l.Class != "" {
+		idx.byClass[l.Class] = append(idx.byClass[l.Class], l)
+	}
+	if l.ShortName != "" {
+		key := strings.ToLower(l.ShortName)
+		idx.byShortName[key] = append(idx.byShortName[key], l)
+	}
+}
+
+// NewLoincIndex indexes records loaded with LoadLoinc.
+func NewLoincIndex(data *LoincData) *LoincIndex {
+	idx := newLoincIndex()
+	if data == nil {
+		return idx
+	}
+
+	for _, l := range *data {
+		if l != nil {
+			idx.add(l)
+		}
+	}
+	idx.version = data.Version()
+
+	return idx
+}
+
+// LoadLoincIndex streams LOINC records from r, or the embedded file when r
+// is nil, keeping only the columns and classes selected by opts.
+func LoadLoincIndex(r io.Reader, opts ...LoincOption) (*LoincIndex, error) {
+	var o loincOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for c := range o.columns {
+		if _, ok := loincColumns[c]; !ok {
+			return nil, fmt.Errorf("ncpdp: unknown LOINC column %q", c)
+		}
+	}
+
+	if r == nil {
+		if len(LoincDataFile) == 0 {
+			return nil, ErrNoLoincData
+		}
+		r = bytes.NewReader(LoincDataFile)
+	}
+
+	cr := csv.NewReader(r)
+	cr.LazyQuotes = true
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("ncpdp: empty LOINC file")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type column struct {
+		index  int
+		field  int
+		intern bool
+	}
+
+	var columns []column
+	classColumn, versionColumn := -1, -1
+	for i, name := range header {
+		switch name {
+		case "CLASS":
+			classColumn = i
+		case "VersionLastChanged":
+			versionColumn = i
+		}
+
+		field, ok := loincColumns[name]
+		if !ok || (o.columns != nil && !o.columns[name]) {
+			continue
+		}
+		columns = append(columns, column{index: i, field: field, intern: internedLoincColumns[name]})
+	}
+
+	idx := newLoincIndex()
+	interned := map[string]string{}
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if versionColumn >= 0 && versionColumn < len(rec) && CompareLoincVersions(rec[versionColumn], idx.version) > 0 {
+			idx.version = strings.Clone(rec[versionColumn])
+		}
+
+		if o.classes != nil && (classColumn < 0 || classColumn >= len(rec) || !o.classes[rec[classColumn]]) {
+			continue
+		}
+
+		l := new(Loinc)
+		v := reflect.ValueOf(l).Elem()
+		for _, c := range columns {
+			if c.index >= len(rec) || rec[c.index] == "" {
+				continue
+			}
+
+			s := rec[c.index]
+			if c.intern {
+				if shared, ok := interned[s]; ok {
+					s = shared
+				} else {
+					s = strings.Clone(s)
+					interned[s] = s
+				}
+			} else {
+				s = strings.Clone(s)
+			}
+			v.Field(c.field).SetString(s)
+		}
+		idx.add(l)
+	}
+
+	if o.version != "" {
+		idx.version = o.version
+	}
+
+	return idx, nil
+}
+
+// LoadLoincIndexFS loads the LOINC table at name in fsys, such as
+// "LoincTable/Loinc.csv" in an unpacked release.
+func LoadLoincIndexFS(fsys fs.FS, name string, opts ...LoincOption) (*LoincIndex, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadLoincIndex(f, opts...)
+}
+
+var defaultLoinc struct {
+	once sync.Once
+	idx  *LoincIndex
+	err  error
+}
+
+// DefaultLoincIndex returns an index of the embedded LOINC file. It is built
+// on first use and shared by every caller.
+func DefaultLoincIndex() (*LoincIndex, error) {
+	defaultLoinc.once.Do(func() {
+		defaultLoinc.idx, defaultLoinc.err = LoadLoincIndex(nil)
+	})
+
+	return defaultLoinc.idx, defaultLoinc.err
+}
+
+func (idx *LoincIndex) Len() int {
+	return len(idx.records)
+}
+
+// Version returns the LOINC release the index was loaded from.
+func (idx *LoincIndex) Version() string {
+	return idx.version
+}
+
+// Version returns the LOINC release of the data, taken as the latest
+// VersionLastChanged of its records.
+func (l *LoincData) Version() string {
+	if l == nil {
+		return ""
+	}
+
+	var version string
+	for _, r := range *l {
+		if CompareLoincVersions(r.VersionLastChanged, version) > 0 {
+			version = r.VersionLastChanged
+		}
+	}
+
+	return version
+}
+
+// CompareLoincVersions compares LOINC release numbers such as "2.72" and
+// "2.8", returning -1, 0 or 1. Suffixes like the "i" of "1.0i" are ignored
+// and an empty version sorts first.
+func CompareLoincVersions(a, b string) int {
+	pa, pb := loincVersionParts(a), loincVersionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	switch {
+	case a == "" && b != "":
+		return -1
+	case a != "" && b == "":
+		return 1
+	}
+
+	return 0
+}
+
+func loincVersionParts(v string) []int {
+	var parts []int
+	for _, p := range strings.Split(strings.TrimSpace(v), ".") {
+		p = strings.TrimRightFunc(p, func(r rune) bool { return r < '0' || r > '9' })
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+
+	return parts
+}
+
+func (idx *LoincIndex) FindByNum(num string) *Loinc {
+	return idx.byNum[strings.TrimSpace(num)]
+}
+
+// FindByComponent returns a copy of the records with the given COMPONENT,
+// ignoring case.
+func (idx *LoincIndex) FindByComponent(component string) []*Loinc {
+	return copyLoincs(idx.byComponent[strings.ToLower(strings.TrimSpace(component))])
+}
+
+// FindByClass returns a copy of the records with the given CLASS.
+func (idx *LoincIndex) FindByClass(class string) []*Loinc {
+	return copyLoincs(idx.byClass[strings.TrimSpace(class)])
+}
+
+// FindByShortName returns a copy of the records with the given SHORTNAME,
+// ignoring case.
+func (idx *LoincIndex) FindByShortName(name string) []*Loinc {
+	return copyLoincs(idx.byShortName[strings.ToLower(strings.TrimSpace(name))])
+}
+
+// copyLoincs copies an indexed slice so callers cannot append to or reorder
+// it.
+func copyLoincs(records []*Loinc) []*Loinc {
+	if records == nil {
+		return nil
+	}
+
+	return append([]*Loinc(nil), records...)
+}
+
+// LOINC STATUS values.
+const (
+	LoincStatusActive      = "ACTIVE"
+	LoincStatusTrial       = "TRIAL"
+	LoincStatusDiscouraged = "DISCOURAGED"
+	LoincStatusDeprecated  = "DEPRECATED"
+)
+
+func (l *LoincData) FindByNum(num string) *Loinc {
+	if l == nil {
+		return nil
+	}
+
+	num = strings.TrimSpace(num)
+	for _, r := range *l {
+		if r.LoincNum == num {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// FindByStatus returns the records with the given STATUS, ignoring case.
+func (l *LoincData) FindByStatus(status string) []*Loinc {
+	if l == nil {
+		return nil
+	}
+
+	var found []*Loinc
+	for _, r := range *l {
+		if strings.EqualFold(r.Status, status) {
+			found = append(found, r)
+		}
+	}
+
+	return found
+}
+
+// Search returns the records whose LONG_COMMON_NAME, SHORTNAME or
+// RELATEDNAMES2 contain every word of query, ignoring case. Exact name
+// matches rank first, then matches on the long common name, the short name
+// and finally the related names.
+func (l *LoincData) Search(query string) []*Loinc {
+	if l == nil {
+		return nil
+	}
+
+	return searchLoinc(*l, query)
+}
+
+// FindByStatus returns a new slice of the records with the given STATUS,
+// ignoring case.
+func (idx *LoincIndex) FindByStatus(status string) []*Loinc {
+	return (*LoincData)(&idx.records).FindByStatus(status)
+}
+
+func (idx *LoincIndex) Search(query string) []*Loinc {
+	return searchLoinc(idx.records, query)
+}
+
+func searchLoinc(records []*Loinc, query string) []*Loinc {
+	query = strings.ToLower(strings.TrimSpace(query))
+	words := strings.Fields(query)
+	if len(words) == 0 {
+		return nil
+	}
+
+	containsAll := func(s string) bool {
+		s = strings.ToLower(s)
+		for _, w := range words {
+			if !strings.Contains(s, w) {
+				return false
+			}
+		}
+		return true
+	}
+
+	type match struct {
+		loinc *Loinc
+		rank  int
+	}
+
+	var matches []match
+	for _, r := range records {
+		rank := 0
+		switch {
+		case strings.ToLower(r.LongCommonName) == query || strings.ToLower(r.ShortName) == query:
+			rank = 1
+		case containsAll(r.LongCommonName):
+			rank = 2
+		case containsAll(r.ShortName):
+			rank = 3
+		case containsAll(r.LongCommonName + " " + r.ShortName + " " + r.RelatedNames2):
+			rank = 4
+		default:
+			continue
+		}
+		matches = append(matches, match{loinc: r, rank: rank})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].rank < matches[j].rank
+	})
+
+	found := make([]*Loinc, len(matches))
+	for i, m := range matches {
+		found[i] = m.loinc
+	}
+
+	return found
+}
+
+// ApplyMapTo sets MapTo from the MapTo.csv file distributed with LOINC
+// releases that no longer carry a MAP_TO column. Codes mapped to several
+// replacements are joined with ";".
+func (l *LoincData) ApplyMapTo(r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+
+	from, to := -1, -1
+	for i, name := range header {
+		switch name {
+		case "LOINC":
+			from = i
+		case "MAP_TO":
+			to = i
+		}
+	}
+	if from < 0 || to < 0 {
+		return errors.New("ncpdp: MapTo file requires LOINC and MAP_TO columns")
+	}
+
+	mapTo := map[string][]string{}
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		mapTo[rec[from]] = append(mapTo[rec[from]], rec[to])
+	}
+
+	if l == nil {
+		return nil
+	}
+
+	for _, r := range *l {
+		if codes, ok := mapTo[r.LoincNum]; ok {
+			r.MapTo = strings.Join(codes, ";")
+		}
+	}
+
+	return nil
+}
+
+func isNilPointer(x interface{}) bool {
+	v := reflect.ValueOf(x)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// LoincLookup resolves LOINC codes; both *LoincData and *LoincIndex
+// implement it.
+type LoincLookup interface {
+	FindByNum(num string) *Loinc
+}
+
+// ValidateMeasurements checks every Measurement in msg against loinc,
+// reporting VitalSign codes that are unknown, DEPRECATED or DISCOURAGED, and
+// units that are not among the code's EXAMPLE_UCUM_UNITS. When loinc also
+// reports its Version, a LOINCVersion newer than the loaded release is
+// flagged too. The returned error is a ValidationErrors, or nil.
+func ValidateMeasurements(msg *Message, loinc LoincLookup) error {
+	if msg == nil || loinc == nil || isNilPointer(loinc) {
+		return errors.New("ncpdp: cannot validate measurements without a message and LOINC data")
+	}
+
+	var loaded string
+	if versioned, ok := loinc.(interface{ Version() string }); ok {
+		loaded = versioned.Version()
+	}
+
+	v := new(validator)
+	walkElements("", reflect.ValueOf(msg), func(path string, x interface{}) {
+		m, ok := x.(*Measurement)
+		if !ok || m.VitalSign == "" {
+			return
+		}
+
+		if loaded != "" && CompareLoincVersions(m.LOINCVersion, loaded) > 0 {
+			v.invalid(join(path, "LOINCVersion"), m.LOINCVersion, "LOINC version is newer than the loaded release %s", loaded)
+		}
+
+		l := loinc.FindByNum(m.VitalSign)
+		if l == nil {
+			v.invalid(join(path, "VitalSign"), m.VitalSign, "unknown LOINC code")
+			return
+		}
+
+		switch strings.ToUpper(l.Status) {
+		case LoincStatusDeprecated, LoincStatusDiscouraged:
+			if l.MapTo != "" {
+				v.invalid(join(path, "VitalSign"), m.VitalSign, "LOINC code is %s, use %s", l.Status, l.MapTo)
+			} else {
+				v.invalid(join(path, "VitalSign"), m.VitalSign, "LOINC code is %s", l.Status)
+			}
+		}
+
+		if l.ExampleUCUMUnits == "" || m.UnitOfMeasure == "" {
+			return
+		}
+		for _, unit := range strings.Split(l.ExampleUCUMUnits, ";") {
+			if strings.TrimSpace(unit) == m.UnitOfMeasure {
+				return
+			}
+		}
+		v.invalid(join(path, "UnitOfMeasure"), m.UnitOfMeasure, "unit does not match %s expected for %s", l.ExampleUCUMUnits, l.LoincNum)
+	})
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
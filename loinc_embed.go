@@ -0,0 +1,11 @@
+//go:build ncpdp_loinc
+
+package ncpdp
+
+import _ "embed"
+
+// LoincDataFile holds Loinc.csv, embedded when built with the ncpdp_loinc
+// tag and a LOINC release copied into the module directory.
+//
+//go:embed Loinc.csv
+var LoincDataFile []byte
@@ -0,0 +1,7 @@
+//go:build !ncpdp_loinc
+
+package ncpdp
+
+// LoincDataFile is empty unless built with the ncpdp_loinc tag; LOINC data
+// is otherwise loaded from a reader or fs.FS.
+var LoincDataFile []byte
@@ -0,0 +1,398 @@
+package ncpdp
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func loadLoincSample(t *testing.T, opts ...LoincOption) *LoincIndex {
+	t.Helper()
+
+	f, err := os.Open("testdata/loinc-sample.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	idx, err := LoadLoincIndex(f, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return idx
+}
+
+func TestLoadLoincIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []LoincOption
+		wantLen       int
+		wantComponent string
+		wantUnits     string
+	}{
+		{
+			name:          "all columns",
+			wantLen:       10,
+			wantComponent: "Body weight",
+			wantUnits:     "kg",
+		},
+		{
+			name:      "selected columns",
+			opts:      []LoincOption{WithLoincColumns("EXAMPLE_UCUM_UNITS")},
+			wantLen:   10,
+			wantUnits: "kg",
+		},
+		{
+			name:          "selected classes",
+			opts:          []LoincOption{WithLoincClasses("BDYWGT.ATOM", "BMI.ATOM")},
+			wantLen:       3,
+			wantComponent: "Body weight",
+			wantUnits:     "kg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := loadLoincSample(t, tt.opts...)
+			if idx.Len() != tt.wantLen {
+				t.Errorf("Len() = %v, want %v", idx.Len(), tt.wantLen)
+			}
+
+			l := idx.FindByNum("29463-7")
+			if l == nil {
+				t.Fatal("FindByNum() = nil")
+			}
+			if l.Component != tt.wantComponent || l.ExampleUCUMUnits != tt.wantUnits {
+				t.Errorf("FindByNum() = %q %q, want %q %q", l.Component, l.ExampleUCUMUnits, tt.wantComponent, tt.wantUnits)
+			}
+		})
+	}
+}
+
+func TestLoincIndexLookups(t *testing.T) {
+	idx := loadLoincSample(t)
+
+	if got := idx.FindByComponent("body WEIGHT"); len(got) != 1 || got[0].LoincNum != "29463-7" {
+		t.Errorf("FindByComponent() = %v", got)
+	}
+	if got := idx.FindByClass("BP.ATOM"); len(got) != 2 {
+		t.Errorf("FindByClass() returned %d records, want 2", len(got))
+	}
+	if got := idx.FindByShortName("bp sys"); len(got) != 1 || got[0].LoincNum != "8480-6" {
+		t.Errorf("FindByShortName() = %v", got)
+	}
+	if got := idx.FindByNum("0000-0"); got != nil {
+		t.Errorf("FindByNum() = %v, want nil", got)
+	}
+
+	lookups := map[string]func() []*Loinc{
+		"FindByComponent": func() []*Loinc { return idx.FindByComponent("body weight") },
+		"FindByClass":     func() []*Loinc { return idx.FindByClass("BP.ATOM") },
+		"FindByShortName": func() []*Loinc { return idx.FindByShortName("bp sys") },
+		"FindByStatus":    func() []*Loinc { return idx.FindByStatus(LoincStatusActive) },
+	}
+	for name, lookup := range lookups {
+		got := lookup()
+		if len(got) == 0 {
+			t.Fatalf("%s() returned no records", name)
+		}
+		want := got[0]
+
+		got[0] = nil
+
+		if again := lookup(); len(again) != len(got) || again[0] != want {
+			t.Errorf("%s() result changed after modifying a previous result: %v", name, again)
+		}
+	}
+
+	data, err := LoadLoinc(strings.NewReader("LOINC_NUM,COMPONENT,CLASS\n8302-2,Body height,BDYHGT.ATOM\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := NewLoincIndex(data).FindByClass("BDYHGT.ATOM"); len(got) != 1 {
+		t.Errorf("NewLoincIndex() FindByClass() returned %d records, want 1", len(got))
+	}
+}
+
+func TestLoadLoincIndexErrors(t *testing.T) {
+	if _, err := LoadLoincIndex(strings.NewReader("")); err == nil {
+		t.Error("LoadLoincIndex() empty file error = nil")
+	}
+	if _, err := LoadLoincIndex(strings.NewReader("LOINC_NUM\n"), WithLoincColumns("NOT_A_COLUMN")); err == nil {
+		t.Error("LoadLoincIndex() unknown column error = nil")
+	}
+}
+
+func TestDefaultLoincIndex(t *testing.T) {
+	var wg sync.WaitGroup
+	indexes := make([]*LoincIndex, 8)
+	for i := range indexes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			idx, err := DefaultLoincIndex()
+			if err != nil && !errors.Is(err, ErrNoLoincData) {
+				t.Errorf("DefaultLoincIndex() error = %v", err)
+			}
+			indexes[i] = idx
+		}(i)
+	}
+	wg.Wait()
+
+	for _, idx := range indexes[1:] {
+		if idx != indexes[0] {
+			t.Fatal("DefaultLoincIndex() returned different indexes")
+		}
+	}
+}
+
+func TestLoincVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		idx  func(t *testing.T) *LoincIndex
+		want string
+	}{
+		{
+			name: "all records",
+			idx:  func(t *testing.T) *LoincIndex { return loadLoincSample(t) },
+			want: "2.76",
+		},
+		{
+			name: "filtered records",
+			idx: func(t *testing.T) *LoincIndex {
+				return loadLoincSample(t, WithLoincColumns("STATUS"), WithLoincClasses("HRTRATE.ATOM"))
+			},
+			want: "2.76",
+		},
+		{
+			name: "explicit version",
+			idx:  func(t *testing.T) *LoincIndex { return loadLoincSample(t, WithLoincVersion("2.77")) },
+			want: "2.77",
+		},
+		{
+			name: "from fs",
+			idx: func(t *testing.T) *LoincIndex {
+				idx, err := LoadLoincIndexFS(os.DirFS("testdata"), "loinc-sample.csv")
+				if err != nil {
+					t.Fatal(err)
+				}
+				return idx
+			},
+			want: "2.76",
+		},
+		{
+			name: "from data",
+			idx:  func(t *testing.T) *LoincIndex { return NewLoincIndex(loadLoincSampleData(t)) },
+			want: "2.76",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.idx(t).Version(); got != tt.want {
+				t.Errorf("Version() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadLoincIndexFS(os.DirFS("testdata"), "missing.csv"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("LoadLoincIndexFS() error = %v, want %v", err, fs.ErrNotExist)
+	}
+}
+
+func TestCompareLoincVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.72", "2.72", 0},
+		{"2.72", "2.76", -1},
+		{"2.8", "2.72", -1},
+		{"2.80", "2.72", 1},
+		{"2.00", "1.0i", 1},
+		{"1.0i", "1.0", 0},
+		{"2", "2.0", 0},
+		{"", "2.72", -1},
+		{"2.72", "", 1},
+		{"", "", 0},
+	}
+
+	for _, tt := range tests {
+		if got := CompareLoincVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareLoincVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func loadLoincSampleData(t *testing.T) *LoincData {
+	t.Helper()
+
+	f, err := os.Open("testdata/loinc-sample.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	data, err := LoadLoinc(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestLoincDataLookups(t *testing.T) {
+	data := loadLoincSampleData(t)
+
+	if got := data.FindByNum("8302-2"); got == nil || got.Component != "Body height" {
+		t.Errorf("FindByNum() = %+v, want Body height", got)
+	}
+	if got := data.FindByNum("0000-0"); got != nil {
+		t.Errorf("FindByNum() = %+v, want nil", got)
+	}
+	if got := data.FindByStatus("deprecated"); len(got) != 1 || got[0].MapTo != "29463-7" {
+		t.Errorf("FindByStatus() = %v, want 99990-1 mapped to 29463-7", got)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "body weight", want: []string{"29463-7", "99990-1"}},
+		{query: "BP sys", want: []string{"8480-6"}},
+		{query: "stature", want: []string{"8302-2", "99991-9"}},
+		{query: "blood pressure", want: []string{"8480-6", "8462-4"}},
+		{query: "", want: nil},
+		{query: "glucose", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			for name, got := range map[string][]*Loinc{
+				"LoincData":  data.Search(tt.query),
+				"LoincIndex": NewLoincIndex(data).Search(tt.query),
+			} {
+				var nums []string
+				for _, l := range got {
+					nums = append(nums, l.LoincNum)
+				}
+				if strings.Join(nums, ",") != strings.Join(tt.want, ",") {
+					t.Errorf("%s.Search() = %v, want %v", name, nums, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyMapTo(t *testing.T) {
+	data, err := LoadLoinc(strings.NewReader("LOINC_NUM,STATUS\n1-1,DEPRECATED\n2-2,ACTIVE\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mapTo := "\"LOINC\",\"MAP_TO\",\"COMMENT\"\n\"1-1\",\"2-2\",\"\"\n\"1-1\",\"3-3\",\"\"\n"
+	if err := data.ApplyMapTo(strings.NewReader(mapTo)); err != nil {
+		t.Fatal(err)
+	}
+	if got := data.FindByNum("1-1").MapTo; got != "2-2;3-3" {
+		t.Errorf("MapTo = %v, want 2-2;3-3", got)
+	}
+
+	if err := data.ApplyMapTo(strings.NewReader("A,B\n")); err == nil {
+		t.Error("ApplyMapTo() missing columns error = nil")
+	}
+}
+
+func TestValidateMeasurements(t *testing.T) {
+	data := loadLoincSampleData(t)
+
+	tests := []struct {
+		name   string
+		mutate func(m []Measurement)
+		want   map[string]string
+	}{
+		{
+			name:   "valid measurements",
+			mutate: func(m []Measurement) {},
+		},
+		{
+			name: "unknown code",
+			mutate: func(m []Measurement) {
+				m[0].VitalSign = "0000-0"
+			},
+			want: map[string]string{"VitalSign": "unknown LOINC code"},
+		},
+		{
+			name: "deprecated code with replacement",
+			mutate: func(m []Measurement) {
+				m[0].VitalSign = "99990-1"
+			},
+			want: map[string]string{"VitalSign": "LOINC code is DEPRECATED, use 29463-7"},
+		},
+		{
+			name: "discouraged code",
+			mutate: func(m []Measurement) {
+				m[0].VitalSign = "99991-9"
+				m[0].UnitOfMeasure = "cm"
+			},
+			want: map[string]string{"VitalSign": "LOINC code is DISCOURAGED"},
+		},
+		{
+			name: "newer LOINC version",
+			mutate: func(m []Measurement) {
+				m[0].LOINCVersion = "2.80"
+			},
+			want: map[string]string{"LOINCVersion": "LOINC version is newer than the loaded release 2.76"},
+		},
+		{
+			name: "unit mismatch",
+			mutate: func(m []Measurement) {
+				m[0].UnitOfMeasure = "[lb_av]"
+			},
+			want: map[string]string{"UnitOfMeasure": "unit does not match kg expected for 29463-7"},
+		},
+	}
+
+	for _, tt := range tests {
+		for name, loinc := range map[string]LoincLookup{"LoincData": data, "LoincIndex": NewLoincIndex(data)} {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				msg := decodeFile(t, "testdata/sample-clinicalinforesponse.xml")
+				tt.mutate(msg.Body.ClinicalInfoResponse.Observation.Measurement)
+
+				err := ValidateMeasurements(msg, loinc)
+				if len(tt.want) == 0 {
+					if err != nil {
+						t.Errorf("ValidateMeasurements() error = %v", err)
+					}
+					return
+				}
+
+				var errs ValidationErrors
+				if !errors.As(err, &errs) || len(errs) != len(tt.want) {
+					t.Fatalf("ValidateMeasurements() error = %v, want %v", err, tt.want)
+				}
+				for _, e := range errs {
+					const prefix = "Body/ClinicalInfoResponse/Observation/Measurement[1]/"
+					if want := tt.want[strings.TrimPrefix(e.Path, prefix)]; e.Message != want {
+						t.Errorf("violation %s = %q, want %q", e.Path, e.Message, want)
+					}
+				}
+			})
+		}
+	}
+
+	if err := ValidateMeasurements(nil, data); err == nil {
+		t.Error("ValidateMeasurements(nil) error = nil")
+	}
+
+	msg := decodeFile(t, "testdata/sample-clinicalinforesponse.xml")
+	for name, loinc := range map[string]LoincLookup{"LoincData": (*LoincData)(nil), "LoincIndex": (*LoincIndex)(nil)} {
+		if err := ValidateMeasurements(msg, loinc); err == nil {
+			t.Errorf("ValidateMeasurements() with a nil %s error = nil", name)
+		}
+	}
+}
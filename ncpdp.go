@@ -6,42 +6,86 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
+	"errors"
 	"io"
 
 	"github.com/gocarina/gocsv"
 )
 
-var (
-	//go:embed NCPDPTerminology.txt
-	NCPDPTerminologyDataFile []byte
+//go:generate go run ./internal/gentermcodes -in NCPDPTerminology.txt -out terminology_codes.go
 
-	//go:embed Loinc.csv
-	LoincDataFile []byte
-)
+//go:embed NCPDPTerminology.txt
+var NCPDPTerminologyDataFile []byte
+
+// ErrNoMessage is returned when JSON input holds null instead of a message.
+var ErrNoMessage = errors.New("ncpdp: no message in JSON input")
 
 type Decoder struct {
-	msg *Message
-	r   io.Reader
-	buf []byte
+	msg     *Message
+	err     error
+	decoded bool
+	r       io.Reader
+	buf     []byte
+	isJson  bool
+	strict  bool
 }
 
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{r: r}
 }
 
+// NewJsonDecoder reads messages in the form produced by ToJson.
+func NewJsonDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r, isJson: true}
+}
+
 func (d *Decoder) Decode() (*Message, error) {
-	return d.msg, d.decode()
+	err := d.decode()
+	return d.msg, err
 }
 
+// decode reads and decodes the message once; later calls return the cached
+// result so repeated elements are not appended to d.msg again.
 func (d *Decoder) decode() error {
+	if !d.decoded {
+		d.err = d.unmarshal()
+		d.decoded = true
+	}
+
+	return d.err
+}
+
+func (d *Decoder) unmarshal() error {
 	if d.buf == nil && d.r != nil {
 		buf := new(bytes.Buffer)
 		buf.ReadFrom(d.r)
 		d.buf = buf.Bytes()
 	}
 
-	return xml.Unmarshal(d.buf, &d.msg)
+	if d.isJson {
+		if err := json.Unmarshal(d.buf, &d.msg); err != nil {
+			return err
+		}
+		if d.msg == nil {
+			return ErrNoMessage
+		}
+		return nil
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(d.buf))
+	if err := dec.Decode(&d.msg); err != nil {
+		if err == io.EOF {
+			return err
+		}
+
+		return newDecodeError(d.buf, dec.InputOffset(), err)
+	}
+
+	if d.strict {
+		return unknownElements(d.buf)
+	}
+
+	return nil
 }
 
 func (d *Decoder) ToJson() ([]byte, error) {
@@ -70,16 +114,12 @@ func LoadTerminology(r io.Reader) (*Terminologies, error) {
 		r = bytes.NewReader(NCPDPTerminologyDataFile)
 	}
 
-	gocsv.SetCSVReader(func(in io.Reader) gocsv.CSVReader {
-		r := csv.NewReader(in)
-		r.Comma = '\t'
-		r.FieldsPerRecord = 7
-
-		return r
-	})
+	cr := csv.NewReader(r)
+	cr.Comma = '\t'
+	cr.FieldsPerRecord = 7
 
 	var data Terminologies
-	if err := gocsv.UnmarshalWithoutHeaders(r, &data); err != nil {
+	if err := gocsv.UnmarshalCSVWithoutHeaders(cr, &data); err != nil {
 		return nil, err
 	}
 
@@ -94,16 +134,13 @@ func (t *Terminologies) Len() int {
 	return len(*t)
 }
 
+// FindTermByQuantityUnitOfMeasureCode returns the preferred term of the
+// QuantityUnitOfMeasure entry with NCIt code s, or "".
+//
+// Deprecated: use FindQuantityUnitOfMeasure.
 func (t Terminologies) FindTermByQuantityUnitOfMeasureCode(s string) string {
-	if len(t) == 0 {
-		fmt.Println("no terminology data loaded")
-		return ""
-	}
-
-	for i := range t {
-		if t[i].NCItCode == s {
-			return t[i].PreferredTerm
-		}
+	if term := t.FindQuantityUnitOfMeasure(s); term != nil {
+		return term.PreferredTerm
 	}
 
 	return ""
@@ -157,19 +194,19 @@ type Loinc struct {
 	VersionFirstReleased      string `csv:"VersionFirstReleased"`
 	ValidHL7AttachmentRequest string `csv:"ValidHL7AttachmentRequest"`
 	DisplayName               string `csv:"DisplayName"`
+	MapTo                     string `csv:"MAP_TO"`
 }
 
 func LoadLoinc(r io.Reader) (*LoincData, error) {
 	if r == nil {
+		if len(LoincDataFile) == 0 {
+			return nil, ErrNoLoincData
+		}
 		r = bytes.NewReader(LoincDataFile)
 	}
 
-	gocsv.SetCSVReader(func(in io.Reader) gocsv.CSVReader {
-		return gocsv.LazyCSVReader(in)
-	})
-
 	var data LoincData
-	if err := gocsv.Unmarshal(r, &data); err != nil {
+	if err := gocsv.UnmarshalCSV(gocsv.LazyCSVReader(r), &data); err != nil {
 		return nil, err
 	}
 
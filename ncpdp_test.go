@@ -1,13 +1,18 @@
 package ncpdp
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestNewDecoder(t *testing.T) {
@@ -87,6 +92,623 @@ func TestNewDecoder(t *testing.T) {
 	}
 }
 
+func TestDecodeTransactions(t *testing.T) {
+	tests := []struct {
+		name  string
+		file  string
+		check func(t *testing.T, msg *Message)
+	}{
+		{
+			name: "rx change request",
+			file: "testdata/sample-rxchangerequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.RxChangeRequest
+				if req == nil {
+					t.Fatal("RxChangeRequest = nil")
+				}
+				if req.MessageRequestCode != ChangePrescriberAuthorization {
+					t.Errorf("MessageRequestCode = %v, want %v", req.MessageRequestCode, ChangePrescriberAuthorization)
+				}
+				if len(req.MessageRequestSubCode) != 2 {
+					t.Errorf("MessageRequestSubCode = %v, want 2 codes", req.MessageRequestSubCode)
+				}
+				if len(req.MedicationRequested) != 1 || req.MedicationRequested[0].Quantity.Value != 30 {
+					t.Errorf("MedicationRequested = %+v", req.MedicationRequested)
+				}
+			},
+		},
+		{
+			name: "rx change response",
+			file: "testdata/sample-rxchangeresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.RxChangeResponse
+				if res == nil {
+					t.Fatal("RxChangeResponse = nil")
+				}
+				if res.Response.Validated == nil || !reflect.DeepEqual(res.Response.Validated.ReasonCode, []string{"GM"}) {
+					t.Errorf("Response = %+v, want Validated GM", res.Response)
+				}
+				if res.MedicationPrescribed == nil {
+					t.Error("MedicationPrescribed = nil")
+				}
+			},
+		},
+		{
+			name: "rx change response with several reason codes",
+			file: "testdata/sample-rxchangeresponse-denied.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.RxChangeResponse
+				if res == nil {
+					t.Fatal("RxChangeResponse = nil")
+				}
+				if res.Response.Denied == nil || !reflect.DeepEqual(res.Response.Denied.ReasonCode, []string{"AL", "AM"}) {
+					t.Errorf("Response = %+v, want Denied AL and AM", res.Response)
+				}
+			},
+		},
+		{
+			name: "cancel rx response",
+			file: "testdata/sample-cancelrxresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.CancelRxResponse
+				if res == nil {
+					t.Fatal("CancelRxResponse = nil")
+				}
+				if res.Response.Denied == nil {
+					t.Fatal("Response.Denied = nil")
+				}
+				if got := res.Response.Denied.Text(); got != "Patient has picked up prescription" {
+					t.Errorf("Denied.Text() = %v", got)
+				}
+			},
+		},
+		{
+			name: "rx fill",
+			file: "testdata/sample-rxfill.xml",
+			check: func(t *testing.T, msg *Message) {
+				fill := msg.Body.RxFill
+				if fill == nil {
+					t.Fatal("RxFill = nil")
+				}
+				if got := fill.FillStatus.Status(); got != FillStatusPartiallyDispensed {
+					t.Errorf("FillStatus.Status() = %v, want %v", got, FillStatusPartiallyDispensed)
+				}
+				if fill.MedicationDispensed == nil || fill.MedicationDispensed.Quantity.Value != 10 {
+					t.Errorf("MedicationDispensed = %+v", fill.MedicationDispensed)
+				}
+			},
+		},
+		{
+			name: "rx fill indicator change",
+			file: "testdata/sample-rxfillindicatorchange.xml",
+			check: func(t *testing.T, msg *Message) {
+				change := msg.Body.RxFillIndicatorChange
+				if change == nil {
+					t.Fatal("RxFillIndicatorChange = nil")
+				}
+				if got := change.MedicationPrescribed.RxFillIndicator; got != RxFillCancelAllFillStatuses {
+					t.Errorf("RxFillIndicator = %v, want %v", got, RxFillCancelAllFillStatuses)
+				}
+			},
+		},
+		{
+			name: "rx history request",
+			file: "testdata/sample-rxhistoryrequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.RxHistoryRequest
+				if req == nil {
+					t.Fatal("RxHistoryRequest = nil")
+				}
+				if req.Consent != "Y" {
+					t.Errorf("Consent = %v, want Y", req.Consent)
+				}
+				if req.RequestedDates == nil || req.RequestedDates.StartDate.Date.Format("2006-01-02") != "2022-03-28" {
+					t.Errorf("RequestedDates = %+v", req.RequestedDates)
+				}
+				if len(req.BenefitsCoordination) != 1 {
+					t.Errorf("BenefitsCoordination = %+v", req.BenefitsCoordination)
+				}
+			},
+		},
+		{
+			name: "rx history response",
+			file: "testdata/sample-rxhistoryresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.RxHistoryResponse
+				if res == nil {
+					t.Fatal("RxHistoryResponse = nil")
+				}
+				if len(res.MedicationDispensed) != 2 {
+					t.Fatalf("MedicationDispensed = %d, want 2", len(res.MedicationDispensed))
+				}
+				med := res.MedicationDispensed[1]
+				if med.HistorySource == nil || *med.HistorySource.FillNumber != 3 || med.HistorySource.Source.SourceQualifier != "P2" {
+					t.Errorf("HistorySource = %+v", med.HistorySource)
+				}
+				if med.Pharmacy == nil || med.Pharmacy.Identification.NCPDPID != "6557744" {
+					t.Errorf("Pharmacy = %+v", med.Pharmacy)
+				}
+				if med.Prescriber == nil || med.Prescriber.NonVeterinarian.Identification.NPI != "1939842031" {
+					t.Errorf("Prescriber = %+v", med.Prescriber)
+				}
+			},
+		},
+		{
+			name: "rx transfer request",
+			file: "testdata/sample-rxtransferrequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.RxTransferRequest
+				if req == nil {
+					t.Fatal("RxTransferRequest = nil")
+				}
+				if req.Pharmacy.Identification.NCPDPID != "3311029" {
+					t.Errorf("Pharmacy = %+v", req.Pharmacy)
+				}
+				if len(req.MedicationRequested) != 1 {
+					t.Errorf("MedicationRequested = %+v", req.MedicationRequested)
+				}
+			},
+		},
+		{
+			name: "rx transfer response",
+			file: "testdata/sample-rxtransferresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.RxTransferResponse
+				if res == nil {
+					t.Fatal("RxTransferResponse = nil")
+				}
+				if res.Response.Approved == nil {
+					t.Error("Response.Approved = nil")
+				}
+				if res.TransferPharmacy.Pharmacist.Name.LastName != "Okafor" {
+					t.Errorf("TransferPharmacy = %+v", res.TransferPharmacy)
+				}
+				if len(res.MedicationTransferred) != 1 || *res.MedicationTransferred[0].RefillsRemaining != 2 {
+					t.Errorf("MedicationTransferred = %+v", res.MedicationTransferred)
+				}
+			},
+		},
+		{
+			name: "rx transfer confirm",
+			file: "testdata/sample-rxtransferconfirm.xml",
+			check: func(t *testing.T, msg *Message) {
+				confirm := msg.Body.RxTransferConfirm
+				if confirm == nil {
+					t.Fatal("RxTransferConfirm = nil")
+				}
+				if confirm.TransferPharmacy.Identification.NCPDPID != "6557744" {
+					t.Errorf("TransferPharmacy = %+v", confirm.TransferPharmacy)
+				}
+			},
+		},
+		{
+			name: "pa initiation response",
+			file: "testdata/sample-painitiationresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.PAInitiationResponse
+				if res == nil {
+					t.Fatal("PAInitiationResponse = nil")
+				}
+				open := res.Response.Open
+				if open == nil || open.QuestionSet == nil {
+					t.Fatalf("Response.Open = %+v", open)
+				}
+				if len(open.QuestionSet.Question) != 4 {
+					t.Errorf("Question = %d, want 4", len(open.QuestionSet.Question))
+				}
+				if open.PADeadlineDate == nil || open.PADeadlineDate.Date.IsZero() {
+					t.Errorf("PADeadlineDate = %+v", open.PADeadlineDate)
+				}
+			},
+		},
+		{
+			name: "pa request",
+			file: "testdata/sample-parequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.PARequest
+				if req == nil || req.QuestionSet == nil {
+					t.Fatal("PARequest.QuestionSet = nil")
+				}
+				if req.PACaseID != "CASE-778812" {
+					t.Errorf("PACaseID = %v", req.PACaseID)
+				}
+				q := req.QuestionSet.Find("Q2")
+				if q == nil || q.QuestionType.Date == nil || q.QuestionType.Date.PrescriberProvidedAnswer == nil {
+					t.Errorf("Find(Q2) = %+v", q)
+				}
+			},
+		},
+		{
+			name: "pa response",
+			file: "testdata/sample-paresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.PAResponse
+				if res == nil || res.Response.Approved == nil {
+					t.Fatal("PAResponse.Response.Approved = nil")
+				}
+				if res.Response.Approved.AuthorizationPeriod == nil {
+					t.Error("AuthorizationPeriod = nil")
+				}
+			},
+		},
+		{
+			name: "pa cancel request",
+			file: "testdata/sample-pacancelrequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.PACancelRequest
+				if req == nil || req.PACancelReasonCode != "PB" {
+					t.Errorf("PACancelRequest = %+v", req)
+				}
+			},
+		},
+		{
+			name: "resupply",
+			file: "testdata/sample-resupply.xml",
+			check: func(t *testing.T, msg *Message) {
+				resupply := msg.Body.Resupply
+				if resupply == nil {
+					t.Fatal("Resupply = nil")
+				}
+				if resupply.Facility.FacilityName != "Sunrise Care Center" {
+					t.Errorf("Facility = %+v", resupply.Facility)
+				}
+				loc := resupply.Patient.HumanPatient.PatientLocation
+				if loc == nil || loc.FacilityUnit != "West Wing" || loc.Room != "214" || loc.Bed != "B" {
+					t.Errorf("PatientLocation = %+v", loc)
+				}
+				if resupply.Patient.HumanPatient.Facility == nil {
+					t.Error("HumanPatient.Facility = nil")
+				}
+			},
+		},
+		{
+			name: "drug administration",
+			file: "testdata/sample-drugadministration.xml",
+			check: func(t *testing.T, msg *Message) {
+				admin := msg.Body.DrugAdministration
+				if admin == nil {
+					t.Fatal("DrugAdministration = nil")
+				}
+				if admin.MedicationPrescribed.Note == "" {
+					t.Error("MedicationPrescribed.Note is empty")
+				}
+			},
+		},
+		{
+			name: "census",
+			file: "testdata/sample-census.xml",
+			check: func(t *testing.T, msg *Message) {
+				census := msg.Body.Census
+				if census == nil {
+					t.Fatal("Census = nil")
+				}
+				if census.CensusReasonCode != "A" {
+					t.Errorf("CensusReasonCode = %v", census.CensusReasonCode)
+				}
+				if census.CensusEffectiveDate == nil || census.CensusEffectiveDate.Date == nil {
+					t.Errorf("CensusEffectiveDate = %+v", census.CensusEffectiveDate)
+				}
+			},
+		},
+		{
+			name: "rems initiation response",
+			file: "testdata/sample-remsinitiationresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.REMSInitiationResponse
+				if res == nil {
+					t.Fatal("REMSInitiationResponse = nil")
+				}
+				if res.REMSCaseID != "REMS-CASE-9001" {
+					t.Errorf("REMSCaseID = %v", res.REMSCaseID)
+				}
+				if got := res.Response.PatientEnrollmentStatus(); got != "NotEnrolled" {
+					t.Errorf("PatientEnrollmentStatus() = %v, want NotEnrolled", got)
+				}
+				if res.Response.Open.QuestionSet == nil {
+					t.Error("Open.QuestionSet = nil")
+				}
+			},
+		},
+		{
+			name: "rems request",
+			file: "testdata/sample-remsrequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.REMSRequest
+				if req == nil {
+					t.Fatal("REMSRequest = nil")
+				}
+				if req.MedicationPrescribed.PrescriberCheckedREMS != "A" {
+					t.Errorf("PrescriberCheckedREMS = %v", req.MedicationPrescribed.PrescriberCheckedREMS)
+				}
+				path, err := req.QuestionSet.Path()
+				if err != nil || len(path) != 1 {
+					t.Errorf("QuestionSet.Path() = %v, %v", path, err)
+				}
+			},
+		},
+		{
+			name: "rems response",
+			file: "testdata/sample-remsresponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.REMSResponse
+				if res == nil || res.Response.Approved == nil {
+					t.Fatal("REMSResponse.Response.Approved = nil")
+				}
+				if got := res.Response.PatientEnrollmentStatus(); got != "Enrolled" {
+					t.Errorf("PatientEnrollmentStatus() = %v, want Enrolled", got)
+				}
+				if res.Response.Approved.REMSAuthorizationNumber != "RA-771230" {
+					t.Errorf("REMSAuthorizationNumber = %v", res.Response.Approved.REMSAuthorizationNumber)
+				}
+			},
+		},
+		{
+			name: "new rx request",
+			file: "testdata/sample-newrxrequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.NewRxRequest
+				if req == nil {
+					t.Fatal("NewRxRequest = nil")
+				}
+				if req.MedicationRequested.DrugDescription == "" {
+					t.Error("MedicationRequested.DrugDescription is empty")
+				}
+			},
+		},
+		{
+			name: "new rx response denied",
+			file: "testdata/sample-newrxresponsedenied.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.NewRxResponseDenied
+				if res == nil || res.Response.Denied == nil {
+					t.Fatal("NewRxResponseDenied.Response.Denied = nil")
+				}
+				if got := res.Response.Denied.Text(); got != "Patient needs appointment" {
+					t.Errorf("Denied.Text() = %v", got)
+				}
+			},
+		},
+		{
+			name: "clinical info request",
+			file: "testdata/sample-clinicalinforequest.xml",
+			check: func(t *testing.T, msg *Message) {
+				req := msg.Body.ClinicalInfoRequest
+				if req == nil {
+					t.Fatal("ClinicalInfoRequest = nil")
+				}
+				if len(req.RequestedObservation) != 2 || req.RequestedObservation[0].VitalSign != "29463-7" {
+					t.Errorf("RequestedObservation = %+v", req.RequestedObservation)
+				}
+			},
+		},
+		{
+			name: "clinical info response",
+			file: "testdata/sample-clinicalinforesponse.xml",
+			check: func(t *testing.T, msg *Message) {
+				res := msg.Body.ClinicalInfoResponse
+				if res == nil || res.Observation == nil {
+					t.Fatal("ClinicalInfoResponse.Observation = nil")
+				}
+				if len(res.Observation.Measurement) != 2 || res.Observation.Measurement[0].Value != "68" {
+					t.Errorf("Measurement = %+v", res.Observation.Measurement)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			msg, err := NewDecoder(file).Decode()
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			tt.check(t, msg)
+		})
+	}
+}
+
+func TestNewJsonDecoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr bool
+	}{
+		{
+			name:    "valid message",
+			file:    "testdata/sample-newrx.xml",
+			wantErr: false,
+		},
+		{
+			name:    "parse quantity float",
+			file:    "testdata/sample-newrx-qty-float.xml",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			xmlDec := NewDecoder(file)
+			want, err := xmlDec.ToJson()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			jsonDec := NewJsonDecoder(bytes.NewReader(want))
+			msg, err := jsonDec.Decode()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			got, err := jsonDec.ToJson()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("ToJson() got = %s, want %s", got, want)
+			}
+
+			wantXml, err := xmlDec.ToXml()
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotXml, err := jsonDec.ToXml()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(gotXml, wantXml) {
+				t.Errorf("ToXml() got = %s, want %s", gotXml, wantXml)
+			}
+
+			if msg.Body.NewRx == nil {
+				t.Error("Decode() NewRx = nil")
+			}
+		})
+	}
+}
+
+func TestNewJsonDecoderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     io.Reader
+		wantErr bool
+	}{
+		{
+			name:    "invalid json",
+			msg:     strings.NewReader("aGVsbG8="),
+			wantErr: true,
+		},
+		{
+			name:    "empty message",
+			msg:     strings.NewReader(""),
+			wantErr: true,
+		},
+		{
+			name:    "date parse error",
+			msg:     strings.NewReader(`{"body":{"new_rx":{"patient":{"human_patient":{"date_of_birth":{"date":"20219-01-01"}}}}}}`),
+			wantErr: true,
+		},
+		{
+			name:    "null message",
+			msg:     strings.NewReader("null"),
+			wantErr: true,
+		},
+		{
+			name:    "timestamp date",
+			msg:     strings.NewReader(`{"body":{"new_rx":{"patient":{"human_patient":{"date_of_birth":{"date":"1984-09-09T00:00:00Z"}}}}}}`),
+			wantErr: false,
+		},
+		{
+			name:    "plain date",
+			msg:     strings.NewReader(`{"body":{"new_rx":{"patient":{"human_patient":{"date_of_birth":{"date":"1984-09-09"}}}}}}`),
+			wantErr: false,
+		},
+		{
+			name:    "null date",
+			msg:     strings.NewReader(`{"body":{"new_rx":{"patient":{"human_patient":{"date_of_birth":{"date":null}}}}}}`),
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewJsonDecoder(tt.msg).Decode()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJsonRoundTripTypes(t *testing.T) {
+	day := time.Date(1984, 9, 9, 0, 0, 0, 0, time.UTC)
+	sent := time.Date(2022, 9, 25, 15, 30, 0, 123000000, time.UTC)
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{
+			name: "date",
+			v:    &DateOfBirth{Date: Date{day}},
+			want: `{"date":"1984-09-09T00:00:00Z"}`,
+		},
+		{
+			name: "zero date",
+			v:    &DateOfBirth{},
+			want: `{"date":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			name: "password",
+			v:    &Password{Value: "secret", Type: "PasswordDigest"},
+			want: `{"Value":"secret","Type":"PasswordDigest"}`,
+		},
+		{
+			name: "qualifier ref",
+			v:    &QualifierRef{Value: "6557744", Qualifier: "P"},
+			want: `{"value":"6557744","qualifier":"P"}`,
+		},
+		{
+			name: "written date time",
+			v:    &WrittenDate{DateTime: &DateTime{DateTime: &sent}},
+			want: `{"date_time":"2022-09-25T15:30:00.123Z"}`,
+		},
+		{
+			name: "written date",
+			v:    &WrittenDate{Date: &Date{day}},
+			want: `{"date":"1984-09-09T00:00:00Z"}`,
+		},
+		{
+			name: "effective date time",
+			v:    &EffectiveDate{DateTime: &DateTime{DateTime: &sent}},
+			want: `{"date_time":"2022-09-25T15:30:00.123Z"}`,
+		},
+		{
+			name: "last fill date",
+			v:    &LastFillDate{Date: &Date{day}},
+			want: `{"date":"1984-09-09T00:00:00Z"}`,
+		},
+		{
+			name: "observation date time",
+			v:    &ObservationDate{DateTime: &DateTime{DateTime: &sent}},
+			want: `{"date_time":"2022-09-25T15:30:00.123Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", b, tt.want)
+			}
+
+			got := reflect.New(reflect.TypeOf(tt.v).Elem()).Interface()
+			if err := json.Unmarshal(b, got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.v) {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.v)
+			}
+		})
+	}
+}
+
 func TestLoadTerminology(t *testing.T) {
 	file, err := os.Open(baseModulePath(t) + "/NCPDPTerminology.txt")
 	if err != nil {
@@ -193,8 +815,15 @@ func TestFindTermByQuantityUnitOfMeasureCode(t *testing.T) {
 		{
 			name:    "valid code",
 			terms:   terms,
+			code:    "C48542",
+			want:    "Tablet",
+			wantErr: false,
+		},
+		{
+			name:    "code from another subset",
+			terms:   terms,
 			code:    "C48672",
-			want:    "Schedule I Substance",
+			want:    "",
 			wantErr: false,
 		},
 		{
@@ -228,12 +857,19 @@ func TestFindTermByQuantityUnitOfMeasureCode(t *testing.T) {
 }
 
 func TestLoadLoinc(t *testing.T) {
-	file, err := os.Open(baseModulePath(t) + "/Loinc.csv")
+	file, err := os.Open(baseModulePath(t) + "/testdata/loinc-sample.csv")
 	if err != nil {
 		t.Fatal(err)
 	}
 	defer file.Close()
 
+	embeddedLen, embeddedErr := 0, false
+	if len(LoincDataFile) == 0 {
+		embeddedErr = true
+	} else {
+		embeddedLen = -1
+	}
+
 	tests := []struct {
 		name    string
 		file    io.Reader
@@ -243,14 +879,14 @@ func TestLoadLoinc(t *testing.T) {
 		{
 			name:    "custom file",
 			file:    file,
-			wantLen: 96635,
+			wantLen: 10,
 			wantErr: false,
 		},
 		{
 			name:    "use embedded file",
 			file:    nil,
-			wantLen: 96635,
-			wantErr: false,
+			wantLen: embeddedLen,
+			wantErr: embeddedErr,
 		},
 		{
 			name:    "invalid file data",
@@ -273,6 +909,13 @@ func TestLoadLoinc(t *testing.T) {
 				t.Errorf("LoadLoinc() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
+			if tt.wantLen < 0 {
+				if got.Len() == 0 {
+					t.Error("LoadLoinc() got no records from the embedded file")
+				}
+				return
+			}
+
 			if got.Len() != tt.wantLen {
 				t.Errorf("LoadLoinc() got = %v, want %v", got.Len(), tt.wantLen)
 			}
@@ -280,6 +923,40 @@ func TestLoadLoinc(t *testing.T) {
 	}
 }
 
+func TestLoadConcurrently(t *testing.T) {
+	const loinc = "LOINC_NUM,COMPONENT,CLASS\n8302-2,Body height,BDYHGT.ATOM\n29463-7,Body weight,BDYWGT.ATOM\n"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			terms, err := LoadTerminology(nil)
+			if err != nil {
+				t.Errorf("LoadTerminology() error = %v", err)
+				return
+			}
+			if terms.Len() != 544 {
+				t.Errorf("LoadTerminology() got = %v, want 544", terms.Len())
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			data, err := LoadLoinc(strings.NewReader(loinc))
+			if err != nil {
+				t.Errorf("LoadLoinc() error = %v", err)
+				return
+			}
+			if data.Len() != 2 || (*data)[1].Component != "Body weight" || (*data)[1].Class != "BDYWGT.ATOM" {
+				t.Errorf("LoadLoinc() parsed %+v", *data)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func baseModulePath(t *testing.T) string {
 	t.Helper()
 	_, b, _, _ := runtime.Caller(0)
@@ -0,0 +1,178 @@
+package ncpdp
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// QuestionSetEnd is the NextQuestionID that terminates a question set.
+const QuestionSetEnd = "END"
+
+const (
+	ComparisonEqual              = "EQ"
+	ComparisonNotEqual           = "NE"
+	ComparisonGreaterThan        = "GT"
+	ComparisonGreaterThanOrEqual = "GE"
+	ComparisonLessThan           = "LT"
+	ComparisonLessThanOrEqual    = "LE"
+)
+
+func (qs *QuestionSet) Find(id string) *Question {
+	if qs == nil {
+		return nil
+	}
+
+	for i := range qs.Question {
+		if qs.Question[i].QuestionID == id {
+			return &qs.Question[i]
+		}
+	}
+
+	return nil
+}
+
+// Path follows the answered questions from the first question in the set and
+// returns every question visited. The path stops at QuestionSetEnd or at the
+// first unanswered question, which is returned as the last element.
+func (qs *QuestionSet) Path() ([]*Question, error) {
+	if qs == nil || len(qs.Question) == 0 {
+		return nil, nil
+	}
+
+	var path []*Question
+	seen := make(map[string]bool)
+	q := &qs.Question[0]
+	for {
+		if seen[q.QuestionID] {
+			return path, fmt.Errorf("question set %s loops at question %s", qs.QuestionSetID, q.QuestionID)
+		}
+		seen[q.QuestionID] = true
+		path = append(path, q)
+
+		next, err := q.NextQuestionID()
+		if err != nil {
+			return path, err
+		}
+		if next == "" || next == QuestionSetEnd {
+			return path, nil
+		}
+
+		q = qs.Find(next)
+		if q == nil {
+			return path, fmt.Errorf("question set %s references unknown question %s", qs.QuestionSetID, next)
+		}
+	}
+}
+
+// NextQuestionID applies the question's branching logic to the prescriber
+// provided answer. It returns "" when the question has not been answered.
+func (q *Question) NextQuestionID() (string, error) {
+	t := q.QuestionType
+	switch {
+	case t.FreeText != nil:
+		if t.FreeText.PrescriberProvidedAnswer == nil {
+			return "", nil
+		}
+		return t.FreeText.NextQuestionID, nil
+
+	case t.Numeric != nil:
+		if t.Numeric.PrescriberProvidedAnswer == nil {
+			return "", nil
+		}
+		answer := *t.Numeric.PrescriberProvidedAnswer
+		for _, c := range t.Numeric.Comparison {
+			value, err := strconv.ParseFloat(c.ComparisonValue, 64)
+			if err != nil {
+				return "", fmt.Errorf("question %s: invalid comparison value %q", q.QuestionID, c.ComparisonValue)
+			}
+			ok, err := compare(c.ComparisonOperator, cmpFloat(answer, value))
+			if err != nil {
+				return "", fmt.Errorf("question %s: %w", q.QuestionID, err)
+			}
+			if ok {
+				return c.NextQuestionID, nil
+			}
+		}
+		return t.Numeric.DefaultNextQuestionID, nil
+
+	case t.Date != nil:
+		if t.Date.PrescriberProvidedAnswer == nil {
+			return "", nil
+		}
+		answer := t.Date.PrescriberProvidedAnswer.Time
+		for _, c := range t.Date.Comparison {
+			value, err := time.Parse("2006-01-02", c.ComparisonValue)
+			if err != nil {
+				return "", fmt.Errorf("question %s: invalid comparison value %q", q.QuestionID, c.ComparisonValue)
+			}
+			ok, err := compare(c.ComparisonOperator, cmpTime(answer, value))
+			if err != nil {
+				return "", fmt.Errorf("question %s: %w", q.QuestionID, err)
+			}
+			if ok {
+				return c.NextQuestionID, nil
+			}
+		}
+		return t.Date.DefaultNextQuestionID, nil
+
+	case t.Select != nil:
+		if t.Select.PrescriberProvidedAnswer == nil || len(t.Select.PrescriberProvidedAnswer.ChoiceID) == 0 {
+			return "", nil
+		}
+		answer := t.Select.PrescriberProvidedAnswer.ChoiceID
+		selected := make(map[string]bool, len(answer))
+		for _, id := range answer {
+			selected[id] = true
+		}
+		for _, c := range t.Select.Choice {
+			if selected[c.ChoiceID] {
+				return c.NextQuestionID, nil
+			}
+		}
+		return "", fmt.Errorf("question %s: answer %v matches no choice", q.QuestionID, answer)
+	}
+
+	return "", fmt.Errorf("question %s has no question type", q.QuestionID)
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func cmpTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+
+	return 0
+}
+
+func compare(operator string, c int) (bool, error) {
+	switch operator {
+	case ComparisonEqual:
+		return c == 0, nil
+	case ComparisonNotEqual:
+		return c != 0, nil
+	case ComparisonGreaterThan:
+		return c > 0, nil
+	case ComparisonGreaterThanOrEqual:
+		return c >= 0, nil
+	case ComparisonLessThan:
+		return c < 0, nil
+	case ComparisonLessThanOrEqual:
+		return c <= 0, nil
+	}
+
+	return false, fmt.Errorf("unknown comparison operator %q", operator)
+}
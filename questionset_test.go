@@ -0,0 +1,153 @@
+package ncpdp
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestQuestionSetPath(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		qs   func(msg *Message) *QuestionSet
+		want []string
+	}{
+		{
+			name: "unanswered",
+			file: "testdata/sample-painitiationresponse.xml",
+			qs:   func(msg *Message) *QuestionSet { return msg.Body.PAInitiationResponse.Response.Open.QuestionSet },
+			want: []string{"Q1"},
+		},
+		{
+			name: "answered",
+			file: "testdata/sample-parequest.xml",
+			qs:   func(msg *Message) *QuestionSet { return msg.Body.PARequest.QuestionSet },
+			want: []string{"Q1", "Q2", "Q4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			msg, err := NewDecoder(file).Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path, err := tt.qs(msg).Path()
+			if err != nil {
+				t.Fatalf("Path() error = %v", err)
+			}
+
+			var got []string
+			for _, q := range path {
+				got = append(got, q.QuestionID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Path() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Path() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNextQuestionID(t *testing.T) {
+	weight := func(v float64) *float64 { return &v }
+	text := "rationale"
+
+	numeric := func(answer *float64, op, value string) Question {
+		return Question{
+			QuestionID: "Q1",
+			QuestionType: QuestionType{Numeric: &NumericQuestion{
+				Comparison:               []Comparison{{ComparisonOperator: op, ComparisonValue: value, NextQuestionID: "Q2"}},
+				DefaultNextQuestionID:    "END",
+				PrescriberProvidedAnswer: answer,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		question Question
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "numeric comparison matches",
+			question: numeric(weight(120), ComparisonGreaterThan, "100"),
+			want:     "Q2",
+		},
+		{
+			name:     "numeric falls through to default",
+			question: numeric(weight(80), ComparisonGreaterThan, "100"),
+			want:     QuestionSetEnd,
+		},
+		{
+			name:     "numeric unanswered",
+			question: numeric(nil, ComparisonGreaterThan, "100"),
+			want:     "",
+		},
+		{
+			name:     "numeric invalid value",
+			question: numeric(weight(80), ComparisonGreaterThan, "heavy"),
+			wantErr:  true,
+		},
+		{
+			name:     "unknown operator",
+			question: numeric(weight(80), "XX", "100"),
+			wantErr:  true,
+		},
+		{
+			name: "date comparison matches",
+			question: Question{QuestionType: QuestionType{Date: &DateQuestion{
+				Comparison:               []Comparison{{ComparisonOperator: ComparisonLessThanOrEqual, ComparisonValue: "2022-01-01", NextQuestionID: "Q3"}},
+				DefaultNextQuestionID:    "Q4",
+				PrescriberProvidedAnswer: &Date{time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)},
+			}}},
+			want: "Q3",
+		},
+		{
+			name: "free text",
+			question: Question{QuestionType: QuestionType{FreeText: &FreeTextQuestion{
+				NextQuestionID:           "Q5",
+				PrescriberProvidedAnswer: &text,
+			}}},
+			want: "Q5",
+		},
+		{
+			name: "select unknown choice",
+			question: Question{QuestionType: QuestionType{Select: &SelectQuestion{
+				Choice:                   []Choice{{ChoiceID: "1", NextQuestionID: "Q2"}},
+				PrescriberProvidedAnswer: &PrescriberProvidedAnswer{ChoiceID: []string{"9"}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:     "no question type",
+			question: Question{QuestionID: "Q9"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.question.NextQuestionID()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextQuestionID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NextQuestionID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
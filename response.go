@@ -0,0 +1,76 @@
+package ncpdp
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// NewMessageID returns a random identifier suitable for Header.MessageID.
+func NewMessageID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// NewStatusResponse builds a Status reply to in sent by software.
+func NewStatusResponse(in *Message, software SenderSoftware, code string) *Message {
+	msg := newResponse(in, software)
+	msg.Body.Status = &Coded{Code: code}
+	return msg
+}
+
+// NewVerifyResponse builds a Verify reply to in sent by software.
+func NewVerifyResponse(in *Message, software SenderSoftware, code string) *Message {
+	msg := newResponse(in, software)
+	msg.Body.Verify = &Verify{VerifyStatus: &Coded{Code: code}}
+	return msg
+}
+
+// NewErrorResponse builds an Error reply to in sent by software. An empty
+// text omits the Description.
+func NewErrorResponse(in *Message, software SenderSoftware, code, descriptionCode, text string) *Message {
+	msg := newResponse(in, software)
+	msg.Body.Error = &Coded{Code: code, DescriptionCode: descriptionCode}
+	if text != "" {
+		msg.Body.Error.Description = &text
+	}
+	return msg
+}
+
+// newResponse addresses a reply back to the sender of in and carries over
+// the identifiers that tie it to the original transaction.
+func newResponse(in *Message, software SenderSoftware) *Message {
+	if in == nil {
+		in = &Message{}
+	}
+
+	msg := &Message{
+		DatatypesVersion:   in.DatatypesVersion,
+		TransportVersion:   in.TransportVersion,
+		TransactionDomain:  in.TransactionDomain,
+		TransactionVersion: in.TransactionVersion,
+		StructuresVersion:  in.StructuresVersion,
+		ECLVersion:         in.ECLVersion,
+		Header: Header{
+			To:                    in.Header.From,
+			From:                  in.Header.To,
+			MessageID:             NewMessageID(),
+			RelatesToMessageID:    in.Header.MessageID,
+			SentTime:              time.Now().UTC(),
+			SenderSoftware:        software,
+			PrescriberOrderNumber: in.Header.PrescriberOrderNumber,
+		},
+	}
+
+	if in.Header.RxReferenceNumber != nil {
+		ref := *in.Header.RxReferenceNumber
+		msg.Header.RxReferenceNumber = &ref
+	}
+
+	return msg
+}
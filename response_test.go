@@ -0,0 +1,111 @@
+package ncpdp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewResponses(t *testing.T) {
+	in := decodeFile(t, "testdata/sample-rxchangerequest.xml")
+	software := SenderSoftware{
+		SenderSoftwareDeveloper:      "A+ Drugs",
+		SenderSoftwareProduct:        "PharmacyOS",
+		SenderSoftwareVersionRelease: "1.4",
+	}
+
+	tests := []struct {
+		name  string
+		msg   *Message
+		check func(t *testing.T, b Body)
+	}{
+		{
+			name: "status",
+			msg:  NewStatusResponse(in, software, StatusTransactionSuccessful),
+			check: func(t *testing.T, b Body) {
+				if b.Status == nil || b.Status.Code != StatusTransactionSuccessful {
+					t.Errorf("Status = %+v, want code %v", b.Status, StatusTransactionSuccessful)
+				}
+			},
+		},
+		{
+			name: "verify",
+			msg:  NewVerifyResponse(in, software, StatusTransactionSuccessful),
+			check: func(t *testing.T, b Body) {
+				if b.Verify == nil || b.Verify.VerifyStatus.Code != StatusTransactionSuccessful {
+					t.Errorf("Verify = %+v, want code %v", b.Verify, StatusTransactionSuccessful)
+				}
+			},
+		},
+		{
+			name: "error",
+			msg:  NewErrorResponse(in, software, ErrorTransactionRejected, DescriptionDuplicateRequest, "Duplicate message"),
+			check: func(t *testing.T, b Body) {
+				e := b.Error
+				if e == nil || e.Code != ErrorTransactionRejected || e.DescriptionCode != DescriptionDuplicateRequest {
+					t.Fatalf("Error = %+v", e)
+				}
+				if e.Description == nil || *e.Description != "Duplicate message" {
+					t.Errorf("Description = %v, want Duplicate message", e.Description)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := tt.msg.Header
+			if h.To != in.Header.From || h.From != in.Header.To {
+				t.Errorf("To/From = %v/%v, want %v/%v", h.To, h.From, in.Header.From, in.Header.To)
+			}
+			if h.RelatesToMessageID != in.Header.MessageID {
+				t.Errorf("RelatesToMessageID = %v, want %v", h.RelatesToMessageID, in.Header.MessageID)
+			}
+			if h.MessageID == "" || h.MessageID == in.Header.MessageID {
+				t.Errorf("MessageID = %v, want a new identifier", h.MessageID)
+			}
+			if h.SentTime.IsZero() {
+				t.Error("SentTime is zero")
+			}
+			if h.PrescriberOrderNumber != in.Header.PrescriberOrderNumber {
+				t.Errorf("PrescriberOrderNumber = %v, want %v", h.PrescriberOrderNumber, in.Header.PrescriberOrderNumber)
+			}
+			if h.RxReferenceNumber == nil || *h.RxReferenceNumber != *in.Header.RxReferenceNumber {
+				t.Errorf("RxReferenceNumber = %v, want %v", h.RxReferenceNumber, *in.Header.RxReferenceNumber)
+			}
+			if h.SenderSoftware != software {
+				t.Errorf("SenderSoftware = %+v, want %+v", h.SenderSoftware, software)
+			}
+			tt.check(t, tt.msg.Body)
+
+			if err := Validate(tt.msg); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := NewEncoder(&buf).Encode(tt.msg); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			got, err := NewDecoder(&buf).Decode()
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if got.Header.RelatesToMessageID != in.Header.MessageID {
+				t.Errorf("decoded RelatesToMessageID = %v, want %v", got.Header.RelatesToMessageID, in.Header.MessageID)
+			}
+		})
+	}
+}
+
+func TestNewMessageID(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id := NewMessageID()
+		if len(id) == 0 || len(id) > 35 {
+			t.Fatalf("NewMessageID() = %q, want 1-35 characters", id)
+		}
+		if seen[id] {
+			t.Fatalf("NewMessageID() returned duplicate %q", id)
+		}
+		seen[id] = true
+	}
+}
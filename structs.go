@@ -1,6 +1,7 @@
 package ncpdp
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"time"
 )
@@ -22,14 +23,14 @@ type Header struct {
 	To                    QualifierRef      `xml:"To" json:"to,omitempty"`
 	From                  QualifierRef      `xml:"From" json:"from,omitempty"`
 	MessageID             string            `xml:"MessageID" json:"message_id,omitempty"`
-	RelatesToMessageID    string            `xml:"RelatesToMessageID" json:"relates_to_message_id,omitempty"`
+	RelatesToMessageID    string            `xml:"RelatesToMessageID,omitempty" json:"relates_to_message_id,omitempty"`
 	SentTime              time.Time         `xml:"SentTime" json:"sent_time,omitempty"`
 	Security              Security          `xml:"Security" json:"security,omitempty"`
 	SenderSoftware        SenderSoftware    `xml:"SenderSoftware" json:"sender_software,omitempty"`
 	Mailbox               *Mailbox          `xml:"Mailbox" json:"mailbox,omitempty"`
 	TestMessage           *bool             `xml:"TestMessage" json:"test_message,omitempty"`
 	RxReferenceNumber     *string           `xml:"RxReferenceNumber" json:"rx_reference_number,omitempty"`
-	PrescriberOrderNumber string            `xml:"PrescriberOrderNumber" json:"prescriber_order_number,omitempty"`
+	PrescriberOrderNumber string            `xml:"PrescriberOrderNumber,omitempty" json:"prescriber_order_number,omitempty"`
 	DigitalSignature      *DigitalSignature `xml:"DigitalSignature" json:"digital_signature,omitempty"`
 }
 
@@ -52,7 +53,7 @@ type TertiaryIdentification struct {
 type UsernameToken struct {
 	Username string    `xml:"Username" json:"username,omitempty"`
 	Password Password  `xml:"Password" json:"password,omitempty"`
-	Nonce    string    `xml:"Nonce" json:"nonce,omitempty"`
+	Nonce    string    `xml:"Nonce,omitempty" json:"nonce,omitempty"`
 	Created  time.Time `xml:"Created" json:"created,omitempty"`
 }
 
@@ -80,14 +81,43 @@ type DigitalSignature struct {
 }
 
 type Body struct {
-	XMLName           xml.Name           `xml:"Body" json:"-"`
-	NewRx             *NewRx             `xml:"NewRx" json:"new_rx,omitempty"`
-	Status            *Coded             `xml:"Status" json:"status,omitempty"`
-	Verify            *Verify            `xml:"Verify" json:"verify,omitempty"`
-	RxRenewalRequest  *RxRenewalRequest  `xml:"RxRenewalRequest" json:"rx_renewal_request,omitempty"`
-	RxRenewalResponse *RxRenewalResponse `xml:"RxRenewalResponse" json:"rx_renewal_response,omitempty"`
-	CancelRx          *CancelRx          `xml:"CancelRx" json:"cancel_rx,omitempty"`
-	Error             *Coded             `xml:"Error" json:"error,omitempty"`
+	XMLName                xml.Name                `xml:"Body" json:"-"`
+	NewRx                  *NewRx                  `xml:"NewRx" json:"new_rx,omitempty"`
+	NewRxRequest           *NewRxRequest           `xml:"NewRxRequest" json:"new_rx_request,omitempty"`
+	NewRxResponseDenied    *NewRxResponseDenied    `xml:"NewRxResponseDenied" json:"new_rx_response_denied,omitempty"`
+	Status                 *Coded                  `xml:"Status" json:"status,omitempty"`
+	Verify                 *Verify                 `xml:"Verify" json:"verify,omitempty"`
+	RxRenewalRequest       *RxRenewalRequest       `xml:"RxRenewalRequest" json:"rx_renewal_request,omitempty"`
+	RxRenewalResponse      *RxRenewalResponse      `xml:"RxRenewalResponse" json:"rx_renewal_response,omitempty"`
+	RxChangeRequest        *RxChangeRequest        `xml:"RxChangeRequest" json:"rx_change_request,omitempty"`
+	RxChangeResponse       *RxChangeResponse       `xml:"RxChangeResponse" json:"rx_change_response,omitempty"`
+	CancelRx               *CancelRx               `xml:"CancelRx" json:"cancel_rx,omitempty"`
+	CancelRxResponse       *CancelRxResponse       `xml:"CancelRxResponse" json:"cancel_rx_response,omitempty"`
+	RxFill                 *RxFill                 `xml:"RxFill" json:"rx_fill,omitempty"`
+	RxFillIndicatorChange  *RxFillIndicatorChange  `xml:"RxFillIndicatorChange" json:"rx_fill_indicator_change,omitempty"`
+	RxHistoryRequest       *RxHistoryRequest       `xml:"RxHistoryRequest" json:"rx_history_request,omitempty"`
+	RxHistoryResponse      *RxHistoryResponse      `xml:"RxHistoryResponse" json:"rx_history_response,omitempty"`
+	RxTransferRequest      *RxTransferRequest      `xml:"RxTransferRequest" json:"rx_transfer_request,omitempty"`
+	RxTransferResponse     *RxTransferResponse     `xml:"RxTransferResponse" json:"rx_transfer_response,omitempty"`
+	RxTransferConfirm      *RxTransferConfirm      `xml:"RxTransferConfirm" json:"rx_transfer_confirm,omitempty"`
+	PAInitiationRequest    *PAInitiationRequest    `xml:"PAInitiationRequest" json:"pa_initiation_request,omitempty"`
+	PAInitiationResponse   *PAInitiationResponse   `xml:"PAInitiationResponse" json:"pa_initiation_response,omitempty"`
+	PARequest              *PARequest              `xml:"PARequest" json:"pa_request,omitempty"`
+	PAResponse             *PAResponse             `xml:"PAResponse" json:"pa_response,omitempty"`
+	PAAppealRequest        *PAAppealRequest        `xml:"PAAppealRequest" json:"pa_appeal_request,omitempty"`
+	PAAppealResponse       *PAAppealResponse       `xml:"PAAppealResponse" json:"pa_appeal_response,omitempty"`
+	PACancelRequest        *PACancelRequest        `xml:"PACancelRequest" json:"pa_cancel_request,omitempty"`
+	PACancelResponse       *PACancelResponse       `xml:"PACancelResponse" json:"pa_cancel_response,omitempty"`
+	Resupply               *Resupply               `xml:"Resupply" json:"resupply,omitempty"`
+	DrugAdministration     *DrugAdministration     `xml:"DrugAdministration" json:"drug_administration,omitempty"`
+	Census                 *Census                 `xml:"Census" json:"census,omitempty"`
+	REMSInitiationRequest  *REMSInitiationRequest  `xml:"REMSInitiationRequest" json:"rems_initiation_request,omitempty"`
+	REMSInitiationResponse *REMSInitiationResponse `xml:"REMSInitiationResponse" json:"rems_initiation_response,omitempty"`
+	REMSRequest            *REMSRequest            `xml:"REMSRequest" json:"rems_request,omitempty"`
+	REMSResponse           *REMSResponse           `xml:"REMSResponse" json:"rems_response,omitempty"`
+	ClinicalInfoRequest    *ClinicalInfoRequest    `xml:"ClinicalInfoRequest" json:"clinical_info_request,omitempty"`
+	ClinicalInfoResponse   *ClinicalInfoResponse   `xml:"ClinicalInfoResponse" json:"clinical_info_response,omitempty"`
+	Error                  *Coded                  `xml:"Error" json:"error,omitempty"`
 }
 
 type NewRx struct {
@@ -101,6 +131,58 @@ type NewRx struct {
 	MedicationPrescribed  Medication             `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
 }
 
+type NewRxRequest struct {
+	XMLName                xml.Name               `xml:"NewRxRequest" json:"-"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	AllergyOrAdverseEvent  *AllergyOrAdverseEvent `xml:"AllergyOrAdverseEvent" json:"allergy_or_adverse_event,omitempty"`
+	BenefitsCoordination   *BenefitsCoordination  `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Facility               *Facility              `xml:"Facility" json:"facility,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy               `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor            `xml:"Supervisor" json:"supervisor,omitempty"`
+	Observation            *Observation           `xml:"Observation" json:"observation,omitempty"`
+	MedicationRequested    Medication             `xml:"MedicationRequested" json:"medication_requested,omitempty"`
+}
+
+type NewRxResponseDenied struct {
+	XMLName                xml.Name    `xml:"NewRxResponseDenied" json:"-"`
+	RequestReferenceNumber *string     `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Response               Response    `xml:"Response" json:"response,omitempty"`
+	Patient                Patient     `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy    `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber  `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationRequested    *Medication `xml:"MedicationRequested" json:"medication_requested,omitempty"`
+}
+
+type ClinicalInfoRequest struct {
+	XMLName                xml.Name               `xml:"ClinicalInfoRequest" json:"-"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy               `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationPrescribed   *Medication            `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	RequestedObservation   []RequestedObservation `xml:"RequestedObservation" json:"requested_observation,omitempty"`
+	Note                   string                 `xml:"Note,omitempty" json:"note,omitempty"`
+}
+
+type RequestedObservation struct {
+	VitalSign    string `xml:"VitalSign" json:"vital_sign,omitempty"`
+	LOINCVersion string `xml:"LOINCVersion,omitempty" json:"loinc_version,omitempty"`
+}
+
+type ClinicalInfoResponse struct {
+	XMLName                xml.Name               `xml:"ClinicalInfoResponse" json:"-"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Response               *Response              `xml:"Response" json:"response,omitempty"`
+	AllergyOrAdverseEvent  *AllergyOrAdverseEvent `xml:"AllergyOrAdverseEvent" json:"allergy_or_adverse_event,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy               `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	Observation            *Observation           `xml:"Observation" json:"observation,omitempty"`
+	MedicationPrescribed   *Medication            `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+}
+
 type Verify struct {
 	XMLName      xml.Name `xml:"Verify" json:"-"`
 	VerifyStatus *Coded   `xml:"VerifyStatus" json:"verify_status,omitempty"`
@@ -138,29 +220,439 @@ type CancelRx struct {
 	MedicationPrescribed Medication `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
 }
 
+type CancelRxResponse struct {
+	XMLName                xml.Name `xml:"CancelRxResponse" json:"-"`
+	RequestReferenceNumber *string  `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Response               Response `xml:"Response" json:"response,omitempty"`
+}
+
+type RxFill struct {
+	XMLName                xml.Name    `xml:"RxFill" json:"-"`
+	RequestReferenceNumber *string     `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	FillStatus             FillStatus  `xml:"FillStatus" json:"fill_status,omitempty"`
+	Patient                Patient     `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy    `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber  `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor `xml:"Supervisor" json:"supervisor,omitempty"`
+	MedicationPrescribed   *Medication `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	MedicationDispensed    *Medication `xml:"MedicationDispensed" json:"medication_dispensed,omitempty"`
+}
+
+type FillStatus struct {
+	Dispensed          *Reason `xml:"Dispensed" json:"dispensed,omitempty"`
+	PartiallyDispensed *Reason `xml:"PartiallyDispensed" json:"partially_dispensed,omitempty"`
+	NotDispensed       *Reason `xml:"NotDispensed" json:"not_dispensed,omitempty"`
+	Transferred        *Reason `xml:"Transferred" json:"transferred,omitempty"`
+}
+
+type RxFillIndicatorChange struct {
+	XMLName                xml.Name    `xml:"RxFillIndicatorChange" json:"-"`
+	RequestReferenceNumber *string     `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Patient                Patient     `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy    `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber  `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor `xml:"Supervisor" json:"supervisor,omitempty"`
+	MedicationPrescribed   Medication  `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+}
+
+type RxHistoryRequest struct {
+	XMLName                xml.Name               `xml:"RxHistoryRequest" json:"-"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	BenefitsCoordination   []BenefitsCoordination `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               *Pharmacy              `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor            `xml:"Supervisor" json:"supervisor,omitempty"`
+	Consent                string                 `xml:"Consent" json:"consent,omitempty"`
+	RequestedDates         *RequestedDates        `xml:"RequestedDates" json:"requested_dates,omitempty"`
+}
+
+type RxHistoryResponse struct {
+	XMLName                xml.Name               `xml:"RxHistoryResponse" json:"-"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Response               *Response              `xml:"Response" json:"response,omitempty"`
+	BenefitsCoordination   []BenefitsCoordination `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               *Pharmacy              `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             *Prescriber            `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationDispensed    []Medication           `xml:"MedicationDispensed" json:"medication_dispensed,omitempty"`
+}
+
+type RequestedDates struct {
+	StartDate RequestedDate `xml:"StartDate" json:"start_date,omitempty"`
+	EndDate   RequestedDate `xml:"EndDate" json:"end_date,omitempty"`
+}
+
+type RequestedDate struct {
+	Date Date `xml:"Date" json:"date,omitempty"`
+}
+
+type HistorySource struct {
+	Source     Source `xml:"Source" json:"source,omitempty"`
+	FillNumber *int   `xml:"FillNumber" json:"fill_number,omitempty"`
+}
+
+type Source struct {
+	SourceDescription string `xml:"SourceDescription,omitempty" json:"source_description,omitempty"`
+	SourceReference   string `xml:"SourceReference,omitempty" json:"source_reference,omitempty"`
+	SourceQualifier   string `xml:"SourceQualifier" json:"source_qualifier,omitempty"`
+}
+
+type RxTransferRequest struct {
+	XMLName                xml.Name     `xml:"RxTransferRequest" json:"-"`
+	RequestReferenceNumber *string      `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Patient                Patient      `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy     `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             *Prescriber  `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationRequested    []Medication `xml:"MedicationRequested" json:"medication_requested,omitempty"`
+}
+
+type RxTransferResponse struct {
+	XMLName                xml.Name         `xml:"RxTransferResponse" json:"-"`
+	RequestReferenceNumber *string          `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Response               Response         `xml:"Response" json:"response,omitempty"`
+	Patient                Patient          `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy         `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	TransferPharmacy       TransferPharmacy `xml:"TransferPharmacy" json:"transfer_pharmacy,omitempty"`
+	Prescriber             *Prescriber      `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationTransferred  []Medication     `xml:"MedicationTransferred" json:"medication_transferred,omitempty"`
+}
+
+type RxTransferConfirm struct {
+	XMLName                xml.Name         `xml:"RxTransferConfirm" json:"-"`
+	RequestReferenceNumber *string          `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Patient                Patient          `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy         `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	TransferPharmacy       TransferPharmacy `xml:"TransferPharmacy" json:"transfer_pharmacy,omitempty"`
+	Prescriber             *Prescriber      `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationTransferred  []Medication     `xml:"MedicationTransferred" json:"medication_transferred,omitempty"`
+}
+
+type Resupply struct {
+	XMLName                xml.Name    `xml:"Resupply" json:"-"`
+	RequestReferenceNumber *string     `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Facility               Facility    `xml:"Facility" json:"facility,omitempty"`
+	Patient                Patient     `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy    `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             *Prescriber `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationDispensed    Medication  `xml:"MedicationDispensed" json:"medication_dispensed,omitempty"`
+}
+
+type DrugAdministration struct {
+	XMLName                xml.Name    `xml:"DrugAdministration" json:"-"`
+	RequestReferenceNumber *string     `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Facility               Facility    `xml:"Facility" json:"facility,omitempty"`
+	Patient                Patient     `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy    `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber  `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor `xml:"Supervisor" json:"supervisor,omitempty"`
+	MedicationPrescribed   Medication  `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+}
+
+type Census struct {
+	XMLName             xml.Name       `xml:"Census" json:"-"`
+	Facility            Facility       `xml:"Facility" json:"facility,omitempty"`
+	Patient             Patient        `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy            *Pharmacy      `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	CensusReasonCode    string         `xml:"CensusReasonCode" json:"census_reason_code,omitempty"`
+	CensusEffectiveDate *EffectiveDate `xml:"CensusEffectiveDate" json:"census_effective_date,omitempty"`
+}
+
+type RxChangeRequest struct {
+	XMLName                xml.Name               `xml:"RxChangeRequest" json:"-"`
+	MessageRequestCode     string                 `xml:"MessageRequestCode" json:"message_request_code,omitempty"`
+	MessageRequestSubCode  []string               `xml:"MessageRequestSubCode" json:"message_request_sub_code,omitempty"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	ChangeReasonText       string                 `xml:"ChangeReasonText,omitempty" json:"change_reason_text,omitempty"`
+	AllergyOrAdverseEvent  *AllergyOrAdverseEvent `xml:"AllergyOrAdverseEvent" json:"allergy_or_adverse_event,omitempty"`
+	BenefitsCoordination   *BenefitsCoordination  `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Facility               *Facility              `xml:"Facility" json:"facility,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy               `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor            `xml:"Supervisor" json:"supervisor,omitempty"`
+	Observation            *Observation           `xml:"Observation" json:"observation,omitempty"`
+	MedicationPrescribed   Medication             `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	MedicationRequested    []Medication           `xml:"MedicationRequested" json:"medication_requested,omitempty"`
+}
+
+type RxChangeResponse struct {
+	XMLName                xml.Name               `xml:"RxChangeResponse" json:"-"`
+	MessageRequestCode     string                 `xml:"MessageRequestCode" json:"message_request_code,omitempty"`
+	MessageRequestSubCode  []string               `xml:"MessageRequestSubCode" json:"message_request_sub_code,omitempty"`
+	RequestReferenceNumber *string                `xml:"RequestReferenceNumber" json:"request_reference_number,omitempty"`
+	Response               Response               `xml:"Response" json:"response,omitempty"`
+	AllergyOrAdverseEvent  *AllergyOrAdverseEvent `xml:"AllergyOrAdverseEvent" json:"allergy_or_adverse_event,omitempty"`
+	BenefitsCoordination   *BenefitsCoordination  `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Facility               *Facility              `xml:"Facility" json:"facility,omitempty"`
+	Patient                Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy               Pharmacy               `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber             Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor             *Supervisor            `xml:"Supervisor" json:"supervisor,omitempty"`
+	Observation            *Observation           `xml:"Observation" json:"observation,omitempty"`
+	MedicationPrescribed   *Medication            `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+}
+
+// MessageRequestCode values for RxChangeRequest and RxChangeResponse.
+const (
+	ChangeGenericSubstitution     = "G"
+	ChangeTherapeuticInterchange  = "T"
+	ChangePriorAuthorization      = "P"
+	ChangeDrugUseEvaluation       = "D"
+	ChangeScriptClarification     = "S"
+	ChangeOutOfStock              = "OS"
+	ChangePrescriberAuthorization = "U"
+)
+
+type PAInitiationRequest struct {
+	XMLName              xml.Name               `xml:"PAInitiationRequest" json:"-"`
+	PAReferenceID        string                 `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	BenefitsCoordination []BenefitsCoordination `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Patient              Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy             *Pharmacy              `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber           Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationPrescribed Medication             `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+}
+
+type PAInitiationResponse struct {
+	XMLName       xml.Name `xml:"PAInitiationResponse" json:"-"`
+	PAReferenceID string   `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID      string   `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	Patient       Patient  `xml:"Patient" json:"patient,omitempty"`
+	Response      PAStatus `xml:"Response" json:"response,omitempty"`
+}
+
+type PARequest struct {
+	XMLName              xml.Name               `xml:"PARequest" json:"-"`
+	PAReferenceID        string                 `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID             string                 `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	PAPriorityIndicator  string                 `xml:"PAPriorityIndicator,omitempty" json:"pa_priority_indicator,omitempty"`
+	BenefitsCoordination []BenefitsCoordination `xml:"BenefitsCoordination" json:"benefits_coordination,omitempty"`
+	Patient              Patient                `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy             *Pharmacy              `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber           Prescriber             `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationPrescribed Medication             `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	QuestionSet          *QuestionSet           `xml:"QuestionSet" json:"question_set,omitempty"`
+}
+
+type PAResponse struct {
+	XMLName       xml.Name `xml:"PAResponse" json:"-"`
+	PAReferenceID string   `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID      string   `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	Patient       Patient  `xml:"Patient" json:"patient,omitempty"`
+	Response      PAStatus `xml:"Response" json:"response,omitempty"`
+}
+
+type PAAppealRequest struct {
+	XMLName              xml.Name     `xml:"PAAppealRequest" json:"-"`
+	PAReferenceID        string       `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID             string       `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	Patient              Patient      `xml:"Patient" json:"patient,omitempty"`
+	Prescriber           Prescriber   `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationPrescribed Medication   `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	AppealReason         string       `xml:"AppealReason,omitempty" json:"appeal_reason,omitempty"`
+	QuestionSet          *QuestionSet `xml:"QuestionSet" json:"question_set,omitempty"`
+}
+
+type PAAppealResponse struct {
+	XMLName       xml.Name `xml:"PAAppealResponse" json:"-"`
+	PAReferenceID string   `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID      string   `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	Patient       Patient  `xml:"Patient" json:"patient,omitempty"`
+	Response      PAStatus `xml:"Response" json:"response,omitempty"`
+}
+
+type PACancelRequest struct {
+	XMLName              xml.Name   `xml:"PACancelRequest" json:"-"`
+	PAReferenceID        string     `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID             string     `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	Patient              Patient    `xml:"Patient" json:"patient,omitempty"`
+	Prescriber           Prescriber `xml:"Prescriber" json:"prescriber,omitempty"`
+	MedicationPrescribed Medication `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	PACancelReasonCode   string     `xml:"PACancelReasonCode" json:"pa_cancel_reason_code,omitempty"`
+}
+
+type PACancelResponse struct {
+	XMLName       xml.Name `xml:"PACancelResponse" json:"-"`
+	PAReferenceID string   `xml:"PAReferenceID" json:"pa_reference_id,omitempty"`
+	PACaseID      string   `xml:"PACaseID,omitempty" json:"pa_case_id,omitempty"`
+	Response      PAStatus `xml:"Response" json:"response,omitempty"`
+}
+
+type PAStatus struct {
+	Open     *PAOpen     `xml:"Open" json:"open,omitempty"`
+	Approved *PADecision `xml:"Approved" json:"approved,omitempty"`
+	Denied   *PADecision `xml:"Denied" json:"denied,omitempty"`
+	Pended   *PADecision `xml:"Pended" json:"pended,omitempty"`
+	Closed   *PADecision `xml:"Closed" json:"closed,omitempty"`
+}
+
+type PAOpen struct {
+	PANote             string         `xml:"PANote,omitempty" json:"pa_note,omitempty"`
+	QuestionSet        *QuestionSet   `xml:"QuestionSet" json:"question_set,omitempty"`
+	AttachmentRequired string         `xml:"AttachmentRequired,omitempty" json:"attachment_required,omitempty"`
+	PADeadlineDate     *RequestedDate `xml:"PADeadlineDate" json:"pa_deadline_date,omitempty"`
+}
+
+type PADecision struct {
+	ReasonCode          []string             `xml:"ReasonCode" json:"reason_code,omitempty"`
+	AuthorizationNumber string               `xml:"AuthorizationNumber,omitempty" json:"authorization_number,omitempty"`
+	AuthorizationPeriod *AuthorizationPeriod `xml:"AuthorizationPeriod" json:"authorization_period,omitempty"`
+	PANote              string               `xml:"PANote,omitempty" json:"pa_note,omitempty"`
+}
+
+type AuthorizationPeriod struct {
+	StartDate RequestedDate `xml:"StartDate" json:"start_date,omitempty"`
+	EndDate   RequestedDate `xml:"EndDate" json:"end_date,omitempty"`
+}
+
+type REMSInitiationRequest struct {
+	XMLName              xml.Name    `xml:"REMSInitiationRequest" json:"-"`
+	REMSReferenceID      string      `xml:"REMSReferenceID" json:"rems_reference_id,omitempty"`
+	Patient              Patient     `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy             *Pharmacy   `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber           Prescriber  `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor           *Supervisor `xml:"Supervisor" json:"supervisor,omitempty"`
+	MedicationPrescribed Medication  `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+}
+
+type REMSInitiationResponse struct {
+	XMLName         xml.Name   `xml:"REMSInitiationResponse" json:"-"`
+	REMSReferenceID string     `xml:"REMSReferenceID" json:"rems_reference_id,omitempty"`
+	REMSCaseID      string     `xml:"REMSCaseID,omitempty" json:"rems_case_id,omitempty"`
+	Patient         Patient    `xml:"Patient" json:"patient,omitempty"`
+	Response        REMSStatus `xml:"Response" json:"response,omitempty"`
+}
+
+type REMSRequest struct {
+	XMLName              xml.Name     `xml:"REMSRequest" json:"-"`
+	REMSReferenceID      string       `xml:"REMSReferenceID" json:"rems_reference_id,omitempty"`
+	REMSCaseID           string       `xml:"REMSCaseID,omitempty" json:"rems_case_id,omitempty"`
+	Patient              Patient      `xml:"Patient" json:"patient,omitempty"`
+	Pharmacy             *Pharmacy    `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber           Prescriber   `xml:"Prescriber" json:"prescriber,omitempty"`
+	Supervisor           *Supervisor  `xml:"Supervisor" json:"supervisor,omitempty"`
+	MedicationPrescribed Medication   `xml:"MedicationPrescribed" json:"medication_prescribed,omitempty"`
+	QuestionSet          *QuestionSet `xml:"QuestionSet" json:"question_set,omitempty"`
+}
+
+type REMSResponse struct {
+	XMLName         xml.Name   `xml:"REMSResponse" json:"-"`
+	REMSReferenceID string     `xml:"REMSReferenceID" json:"rems_reference_id,omitempty"`
+	REMSCaseID      string     `xml:"REMSCaseID,omitempty" json:"rems_case_id,omitempty"`
+	Patient         Patient    `xml:"Patient" json:"patient,omitempty"`
+	Response        REMSStatus `xml:"Response" json:"response,omitempty"`
+}
+
+type REMSStatus struct {
+	Open     *REMSOpen     `xml:"Open" json:"open,omitempty"`
+	Approved *REMSDecision `xml:"Approved" json:"approved,omitempty"`
+	Denied   *REMSDecision `xml:"Denied" json:"denied,omitempty"`
+	Closed   *REMSDecision `xml:"Closed" json:"closed,omitempty"`
+}
+
+type REMSOpen struct {
+	PatientEnrollmentStatus string       `xml:"PatientEnrollmentStatus,omitempty" json:"patient_enrollment_status,omitempty"`
+	REMSNote                string       `xml:"REMSNote,omitempty" json:"rems_note,omitempty"`
+	QuestionSet             *QuestionSet `xml:"QuestionSet" json:"question_set,omitempty"`
+}
+
+type REMSDecision struct {
+	ReasonCode              []string             `xml:"ReasonCode" json:"reason_code,omitempty"`
+	PatientEnrollmentStatus string               `xml:"PatientEnrollmentStatus,omitempty" json:"patient_enrollment_status,omitempty"`
+	REMSAuthorizationNumber string               `xml:"REMSAuthorizationNumber,omitempty" json:"rems_authorization_number,omitempty"`
+	AuthorizationPeriod     *AuthorizationPeriod `xml:"AuthorizationPeriod" json:"authorization_period,omitempty"`
+	REMSNote                string               `xml:"REMSNote,omitempty" json:"rems_note,omitempty"`
+}
+
+type QuestionSet struct {
+	QuestionSetID          string     `xml:"QuestionSetID" json:"question_set_id,omitempty"`
+	QuestionSetVersion     string     `xml:"QuestionSetVersion" json:"question_set_version,omitempty"`
+	QuestionSetTitle       string     `xml:"QuestionSetTitle,omitempty" json:"question_set_title,omitempty"`
+	QuestionSetDescription string     `xml:"QuestionSetDescription,omitempty" json:"question_set_description,omitempty"`
+	Question               []Question `xml:"Question" json:"question,omitempty"`
+}
+
+type Question struct {
+	QuestionID             string       `xml:"QuestionID" json:"question_id,omitempty"`
+	QuestionSequenceNumber string       `xml:"QuestionSequenceNumber,omitempty" json:"question_sequence_number,omitempty"`
+	QuestionText           string       `xml:"QuestionText" json:"question_text,omitempty"`
+	QuestionType           QuestionType `xml:"QuestionType" json:"question_type,omitempty"`
+}
+
+type QuestionType struct {
+	FreeText *FreeTextQuestion `xml:"FreeText" json:"free_text,omitempty"`
+	Numeric  *NumericQuestion  `xml:"Numeric" json:"numeric,omitempty"`
+	Date     *DateQuestion     `xml:"Date" json:"date,omitempty"`
+	Select   *SelectQuestion   `xml:"Select" json:"select,omitempty"`
+}
+
+type FreeTextQuestion struct {
+	NextQuestionID           string  `xml:"NextQuestionID" json:"next_question_id,omitempty"`
+	PrescriberProvidedAnswer *string `xml:"PrescriberProvidedAnswer" json:"prescriber_provided_answer,omitempty"`
+}
+
+type NumericQuestion struct {
+	Comparison               []Comparison `xml:"Comparison" json:"comparison,omitempty"`
+	DefaultNextQuestionID    string       `xml:"DefaultNextQuestionID" json:"default_next_question_id,omitempty"`
+	PrescriberProvidedAnswer *float64     `xml:"PrescriberProvidedAnswer" json:"prescriber_provided_answer,omitempty"`
+}
+
+type DateQuestion struct {
+	Comparison               []Comparison `xml:"Comparison" json:"comparison,omitempty"`
+	DefaultNextQuestionID    string       `xml:"DefaultNextQuestionID" json:"default_next_question_id,omitempty"`
+	PrescriberProvidedAnswer *Date        `xml:"PrescriberProvidedAnswer" json:"prescriber_provided_answer,omitempty"`
+}
+
+type Comparison struct {
+	ComparisonOperator string `xml:"ComparisonOperator" json:"comparison_operator,omitempty"`
+	ComparisonValue    string `xml:"ComparisonValue" json:"comparison_value,omitempty"`
+	NextQuestionID     string `xml:"NextQuestionID" json:"next_question_id,omitempty"`
+}
+
+type SelectQuestion struct {
+	SelectMultiple           string                    `xml:"SelectMultiple" json:"select_multiple,omitempty"`
+	Choice                   []Choice                  `xml:"Choice" json:"choice,omitempty"`
+	PrescriberProvidedAnswer *PrescriberProvidedAnswer `xml:"PrescriberProvidedAnswer" json:"prescriber_provided_answer,omitempty"`
+}
+
+type PrescriberProvidedAnswer struct {
+	ChoiceID []string `xml:"ChoiceID" json:"choice_id,omitempty"`
+}
+
+type Choice struct {
+	ChoiceID             string `xml:"ChoiceID" json:"choice_id,omitempty"`
+	ChoiceSequenceNumber string `xml:"ChoiceSequenceNumber,omitempty" json:"choice_sequence_number,omitempty"`
+	ChoiceText           string `xml:"ChoiceText" json:"choice_text,omitempty"`
+	NextQuestionID       string `xml:"NextQuestionID" json:"next_question_id,omitempty"`
+}
+
 type Response struct {
 	Approved            *Reason   `xml:"Approved" json:"approved,omitempty"`
 	Replace             *struct{} `xml:"Replace" json:"replace,omitempty"`
-	ApprovedWithChanges *struct{} `xml:"ApprovedWithChanges" json:"approved_with_changes,omitempty"`
+	ApprovedWithChanges *Reason   `xml:"ApprovedWithChanges" json:"approved_with_changes,omitempty"`
 	Denied              *Reason   `xml:"Denied" json:"denied,omitempty"`
+	Validated           *Reason   `xml:"Validated" json:"validated,omitempty"`
 }
 
 type Reason struct {
-	ReasonCode      *string `xml:"ReasonCode" json:"reason_code,omitempty"`
-	ReferenceNumber *string `xml:"ReferenceNumber" json:"reference_number,omitempty"`
-	DenialReason    *string `xml:"DenialReason" json:"denial_reason,omitempty"`
+	ReasonCode      []string `xml:"ReasonCode" json:"reason_code,omitempty"`
+	ReferenceNumber *string  `xml:"ReferenceNumber" json:"reference_number,omitempty"`
+	DenialReason    *string  `xml:"DenialReason" json:"denial_reason,omitempty"`
+	Note            *string  `xml:"Note" json:"note,omitempty"`
 }
 
 type Facility struct {
 	Identification       PayerIdentification  `xml:"Identification" json:"identification,omitempty"`
-	FacilityName         string               `xml:"FacilityName" json:"facility_name,omitempty"`
+	FacilityName         string               `xml:"FacilityName,omitempty" json:"facility_name,omitempty"`
 	Address              Address              `xml:"Address" json:"address,omitempty"`
 	CommunicationNumbers CommunicationNumbers `xml:"CommunicationNumbers" json:"communication_numbers,omitempty"`
 }
 
 type Patient struct {
-	XMLName      xml.Name     `xml:"Patient" json:"-"`
-	HumanPatient HumanPatient `xml:"HumanPatient" json:"human_patient,omitempty"`
+	XMLName         xml.Name         `xml:"Patient" json:"-"`
+	HumanPatient    HumanPatient     `xml:"HumanPatient" json:"human_patient,omitempty"`
+	NonHumanPatient *NonHumanPatient `xml:"NonHumanPatient" json:"non_human_patient,omitempty"`
 }
 
 type HumanPatient struct {
@@ -171,7 +663,29 @@ type HumanPatient struct {
 	DateOfBirth          DateOfBirth            `xml:"DateOfBirth" json:"date_of_birth,omitempty"`
 	Address              Address                `xml:"Address" json:"address,omitempty"`
 	CommunicationNumbers CommunicationNumbers   `xml:"CommunicationNumbers" json:"communication_numbers,omitempty"`
-	LanguageNameCode     string                 `xml:"LanguageNameCode" json:"language_name_code,omitempty"`
+	LanguageNameCode     string                 `xml:"LanguageNameCode,omitempty" json:"language_name_code,omitempty"`
+	Facility             *Facility              `xml:"Facility" json:"facility,omitempty"`
+	PatientLocation      *PatientLocation       `xml:"PatientLocation" json:"patient_location,omitempty"`
+}
+
+// NonHumanPatient is an animal patient. Name holds the owner's name.
+type NonHumanPatient struct {
+	XMLName              xml.Name               `xml:"NonHumanPatient" json:"-"`
+	Identification       *PatientIdentification `xml:"Identification" json:"identification,omitempty"`
+	Name                 Name                   `xml:"Name" json:"name,omitempty"`
+	Gender               string                 `xml:"Gender" json:"gender,omitempty"`
+	DateOfBirth          DateOfBirth            `xml:"DateOfBirth" json:"date_of_birth,omitempty"`
+	Address              Address                `xml:"Address" json:"address,omitempty"`
+	CommunicationNumbers CommunicationNumbers   `xml:"CommunicationNumbers" json:"communication_numbers,omitempty"`
+	Species              string                 `xml:"Species" json:"species,omitempty"`
+	AnimalName           string                 `xml:"AnimalName" json:"animal_name,omitempty"`
+	Breed                string                 `xml:"Breed,omitempty" json:"breed,omitempty"`
+}
+
+type PatientLocation struct {
+	FacilityUnit string `xml:"FacilityUnit,omitempty" json:"facility_unit,omitempty"`
+	Room         string `xml:"Room,omitempty" json:"room,omitempty"`
+	Bed          string `xml:"Bed,omitempty" json:"bed,omitempty"`
 }
 
 type PatientIdentification struct {
@@ -183,8 +697,8 @@ type Name struct {
 	FirstName  string  `xml:"FirstName" json:"first_name,omitempty"`
 	MiddleName *string `xml:"MiddleName" json:"middle_name,omitempty"`
 	LastName   string  `xml:"LastName" json:"last_name,omitempty"`
-	Prefix     string  `xml:"Prefix" json:"prefix,omitempty"`
-	Suffix     string  `xml:"Suffix" json:"suffix,omitempty"`
+	Prefix     string  `xml:"Prefix,omitempty" json:"prefix,omitempty"`
+	Suffix     string  `xml:"Suffix,omitempty" json:"suffix,omitempty"`
 }
 
 type DateOfBirth struct {
@@ -203,11 +717,11 @@ type DateTime struct {
 type Address struct {
 	XMLName       xml.Name `xml:"Address" json:"-"`
 	AddressLine1  string   `xml:"AddressLine1" json:"address_line_1,omitempty"`
-	AddressLine2  string   `xml:"AddressLine2" json:"address_line_2,omitempty"`
+	AddressLine2  string   `xml:"AddressLine2,omitempty" json:"address_line_2,omitempty"`
 	City          string   `xml:"City" json:"city,omitempty"`
-	StateProvince string   `xml:"StateProvince" json:"state_province,omitempty"`
-	PostalCode    string   `xml:"PostalCode" json:"postal_code,omitempty"`
-	CountryCode   string   `xml:"CountryCode" json:"country_code,omitempty"`
+	StateProvince string   `xml:"StateProvince,omitempty" json:"state_province,omitempty"`
+	PostalCode    string   `xml:"PostalCode,omitempty" json:"postal_code,omitempty"`
+	CountryCode   string   `xml:"CountryCode,omitempty" json:"country_code,omitempty"`
 }
 
 type PrescriberAgent struct {
@@ -221,12 +735,12 @@ type CommunicationNumbers struct {
 	OtherTelephone   *Telephone `xml:"OtherTelephone" json:"other_telephone,omitempty"`
 	Fax              *Fax       `xml:"Fax" json:"fax,omitempty"`
 	WorkTelephone    *Telephone `xml:"WorkTelephone" json:"work_telephone,omitempty"`
-	ElectronicMail   string     `xml:"ElectronicMail" json:"electronic_mail,omitempty"`
+	ElectronicMail   string     `xml:"ElectronicMail,omitempty" json:"electronic_mail,omitempty"`
 }
 
 type Telephone struct {
 	Number      string `xml:"Number" json:"number,omitempty"`
-	SupportsSMS string `xml:"SupportsSMS" json:"supports_sms,omitempty"`
+	SupportsSMS string `xml:"SupportsSMS,omitempty" json:"supports_sms,omitempty"`
 }
 
 type Fax struct {
@@ -243,12 +757,21 @@ type Pharmacy struct {
 	CommunicationNumbers CommunicationNumbers   `xml:"CommunicationNumbers" json:"communication_numbers,omitempty"`
 }
 
+type TransferPharmacy struct {
+	XMLName              xml.Name               `xml:"TransferPharmacy" json:"-"`
+	Identification       ProviderIdentification `xml:"Identification" json:"identification,omitempty"`
+	Pharmacist           Pharmacist             `xml:"Pharmacist" json:"pharmacist,omitempty"`
+	BusinessName         string                 `xml:"BusinessName" json:"business_name,omitempty"`
+	Address              Address                `xml:"Address" json:"address,omitempty"`
+	CommunicationNumbers CommunicationNumbers   `xml:"CommunicationNumbers" json:"communication_numbers,omitempty"`
+}
+
 type ProviderIdentification struct {
 	XMLName            xml.Name `xml:"Identification" json:"-"`
-	NCPDPID            string   `xml:"NCPDPID" json:"ncpdpid,omitempty"`
-	NPI                string   `xml:"NPI" json:"npi,omitempty"`
-	DEANumber          string   `xml:"DEANumber" json:"dea_number,omitempty"`
-	StateLicenseNumber string   `xml:"StateLicenseNumber" json:"state_license_number,omitempty"`
+	NCPDPID            string   `xml:"NCPDPID,omitempty" json:"ncpdpid,omitempty"`
+	NPI                string   `xml:"NPI,omitempty" json:"npi,omitempty"`
+	DEANumber          string   `xml:"DEANumber,omitempty" json:"dea_number,omitempty"`
+	StateLicenseNumber string   `xml:"StateLicenseNumber,omitempty" json:"state_license_number,omitempty"`
 }
 
 type Pharmacist struct {
@@ -258,17 +781,30 @@ type Pharmacist struct {
 type Prescriber struct {
 	XMLName         xml.Name        `xml:"Prescriber" json:"-"`
 	NonVeterinarian NonVeterinarian `xml:"NonVeterinarian" json:"non_veterinarian,omitempty"`
+	Veterinarian    *Veterinarian   `xml:"Veterinarian" json:"veterinarian,omitempty"`
 }
 
 type Supervisor struct {
 	XMLName         xml.Name        `xml:"Supervisor" json:"-"`
 	NonVeterinarian NonVeterinarian `xml:"NonVeterinarian" json:"non_veterinarian,omitempty"`
+	Veterinarian    *Veterinarian   `xml:"Veterinarian" json:"veterinarian,omitempty"`
 }
 
 type NonVeterinarian struct {
 	XMLName              xml.Name               `xml:"NonVeterinarian" json:"-"`
 	Identification       ProviderIdentification `xml:"Identification" json:"identification,omitempty"`
-	Specialty            string                 `xml:"Specialty" json:"specialty,omitempty"`
+	Specialty            string                 `xml:"Specialty,omitempty" json:"specialty,omitempty"`
+	PracticeLocation     *PracticeLocation      `xml:"PracticeLocation" json:"practice_location,omitempty"`
+	Name                 Name                   `xml:"Name" json:"name,omitempty"`
+	Address              Address                `xml:"Address" json:"address,omitempty"`
+	PrescriberAgent      *PrescriberAgent       `xml:"PrescriberAgent" json:"prescriber_agent,omitempty"`
+	CommunicationNumbers CommunicationNumbers   `xml:"CommunicationNumbers" json:"communication_numbers,omitempty"`
+}
+
+type Veterinarian struct {
+	XMLName              xml.Name               `xml:"Veterinarian" json:"-"`
+	Identification       ProviderIdentification `xml:"Identification" json:"identification,omitempty"`
+	Specialty            string                 `xml:"Specialty,omitempty" json:"specialty,omitempty"`
 	PracticeLocation     *PracticeLocation      `xml:"PracticeLocation" json:"practice_location,omitempty"`
 	Name                 Name                   `xml:"Name" json:"name,omitempty"`
 	Address              Address                `xml:"Address" json:"address,omitempty"`
@@ -303,24 +839,28 @@ type Medication struct {
 	DrugDescription           string               `xml:"DrugDescription" json:"drug_description,omitempty"`
 	DrugCoded                 DrugCoded            `xml:"DrugCoded" json:"drug_coded,omitempty"`
 	Quantity                  Quantity             `xml:"Quantity" json:"quantity,omitempty"`
-	DaysSupply                float64              `xml:"DaysSupply" json:"days_supply,omitempty"`
+	DaysSupply                float64              `xml:"DaysSupply,omitempty" json:"days_supply,omitempty"`
 	WrittenDate               WrittenDate          `xml:"WrittenDate" json:"written_date,omitempty"`
 	LastFillDate              *LastFillDate        `xml:"LastFillDate" json:"last_fill_date,omitempty"`
 	Substitutions             *int                 `xml:"Substitutions" json:"substitutions,omitempty"`
 	NumberOfRefills           *int                 `xml:"NumberOfRefills" json:"number_of_refills,omitempty"`
+	RefillsRemaining          *int                 `xml:"RefillsRemaining" json:"refills_remaining,omitempty"`
 	Diagnosis                 []Diagnosis          `xml:"Diagnosis" json:"diagnosis,omitempty"`
-	Note                      string               `xml:"Note" json:"note,omitempty"`
+	Note                      string               `xml:"Note,omitempty" json:"note,omitempty"`
 	Sig                       Sig                  `xml:"Sig" json:"sig,omitempty"`
-	RxFillIndicator           string               `xml:"RxFillIndicator" json:"rx_fill_indicator,omitempty"`
-	PriorAuthorizationStatus  string               `xml:"PriorAuthorizationStatus" json:"prior_authorization_status,omitempty"`
-	PrescriberCheckedREMS     string               `xml:"PrescriberCheckedREMS" json:"prescriber_checked_rems,omitempty"`
-	OfficeOfPharmacyAffairsID string               `xml:"OfficeOfPharmacyAffairsID" json:"office_of_pharmacy_affairs_id,omitempty"`
+	RxFillIndicator           string               `xml:"RxFillIndicator,omitempty" json:"rx_fill_indicator,omitempty"`
+	PriorAuthorizationStatus  string               `xml:"PriorAuthorizationStatus,omitempty" json:"prior_authorization_status,omitempty"`
+	PrescriberCheckedREMS     string               `xml:"PrescriberCheckedREMS,omitempty" json:"prescriber_checked_rems,omitempty"`
+	OfficeOfPharmacyAffairsID string               `xml:"OfficeOfPharmacyAffairsID,omitempty" json:"office_of_pharmacy_affairs_id,omitempty"`
 	OtherMedicationDate       *OtherMedicationDate `xml:"OtherMedicationDate" json:"other_medication_date,omitempty"`
-	PharmacyRequestedRefills  int                  `xml:"PharmacyRequestedRefills" json:"pharmacy_requested_refills,omitempty"`
+	PharmacyRequestedRefills  int                  `xml:"PharmacyRequestedRefills,omitempty" json:"pharmacy_requested_refills,omitempty"`
+	HistorySource             *HistorySource       `xml:"HistorySource" json:"history_source,omitempty"`
+	Pharmacy                  *Pharmacy            `xml:"Pharmacy" json:"pharmacy,omitempty"`
+	Prescriber                *Prescriber          `xml:"Prescriber" json:"prescriber,omitempty"`
 }
 
 type AllergyOrAdverseEvent struct {
-	NoKnownAllergies string      `xml:"NoKnownAllergies" json:"no_known_allergies,omitempty"`
+	NoKnownAllergies string      `xml:"NoKnownAllergies,omitempty" json:"no_known_allergies,omitempty"`
 	Allergies        []Allergies `xml:"Allergies" json:"allergies,omitempty"`
 }
 
@@ -338,19 +878,19 @@ type EffectiveDate struct {
 
 type BenefitsCoordination struct {
 	PayerIdentification PayerIdentification `xml:"PayerIdentification" json:"payer_identification,omitempty"`
-	PayerName           string              `xml:"PayerName" json:"payer_name,omitempty"`
-	CardholderID        string              `xml:"CardholderID" json:"cardholder_id,omitempty"`
+	PayerName           string              `xml:"PayerName,omitempty" json:"payer_name,omitempty"`
+	CardholderID        string              `xml:"CardholderID,omitempty" json:"cardholder_id,omitempty"`
 	CardHolderName      *Name               `xml:"CardHolderName" json:"card_holder_name,omitempty"`
-	GroupID             string              `xml:"GroupID" json:"group_id,omitempty"`
-	GroupName           string              `xml:"GroupName" json:"group_name,omitempty"`
-	PBMMemberID         string              `xml:"PBMMemberID" json:"pbm_member_id,omitempty"`
+	GroupID             string              `xml:"GroupID,omitempty" json:"group_id,omitempty"`
+	GroupName           string              `xml:"GroupName,omitempty" json:"group_name,omitempty"`
+	PBMMemberID         string              `xml:"PBMMemberID,omitempty" json:"pbm_member_id,omitempty"`
 }
 
 type PayerIdentification struct {
-	MutuallyDefined               string `xml:"MutuallyDefined" json:"mutually_defined,omitempty"`
-	IINNumber                     string `xml:"IINNumber" json:"iin_number,omitempty"`
-	PayerID                       string `xml:"PayerID" json:"payer_id,omitempty"`
-	ProcessorIdentificationNumber string `xml:"ProcessorIdentificationNumber" json:"processor_identification_number,omitempty"`
+	MutuallyDefined               string `xml:"MutuallyDefined,omitempty" json:"mutually_defined,omitempty"`
+	IINNumber                     string `xml:"IINNumber,omitempty" json:"iin_number,omitempty"`
+	PayerID                       string `xml:"PayerID,omitempty" json:"payer_id,omitempty"`
+	ProcessorIdentificationNumber string `xml:"ProcessorIdentificationNumber,omitempty" json:"processor_identification_number,omitempty"`
 }
 
 type DrugCoded struct {
@@ -362,13 +902,14 @@ type DrugCoded struct {
 }
 
 type Coded struct {
-	Code        string  `xml:"Code" json:"code,omitempty"`
-	Qualifier   string  `xml:"Qualifier" json:"qualifier,omitempty"`
-	Description *string `xml:"Description" json:"description,omitempty"`
+	Code            string  `xml:"Code" json:"code,omitempty"`
+	Qualifier       string  `xml:"Qualifier,omitempty" json:"qualifier,omitempty"`
+	DescriptionCode string  `xml:"DescriptionCode,omitempty" json:"description_code,omitempty"`
+	Description     *string `xml:"Description" json:"description,omitempty"`
 }
 
 type Strength struct {
-	StrengthValue         string         `xml:"StrengthValue" json:"strength_value,omitempty"`
+	StrengthValue         string         `xml:"StrengthValue,omitempty" json:"strength_value,omitempty"`
 	StrengthForm          *UnitOfMeasure `xml:"StrengthForm" json:"strength_form,omitempty"`
 	StrengthUnitOfMeasure *UnitOfMeasure `xml:"StrengthUnitOfMeasure" json:"strength_unit_of_measure,omitempty"`
 }
@@ -406,14 +947,14 @@ type Diagnosis struct {
 }
 
 type Sig struct {
-	SigText     string       `xml:"SigText" json:"sig_text,omitempty"`
+	SigText     string       `xml:"SigText,omitempty" json:"sig_text,omitempty"`
 	CodeSystem  *CodeSystem  `xml:"CodeSystem" json:"code_system,omitempty"`
 	Instruction *Instruction `xml:"Instruction" json:"instruction,omitempty"`
 }
 
 type CodeSystem struct {
-	SNOMEDVersion string `xml:"SNOMEDVersion" json:"snomed_version,omitempty"`
-	FMTVersion    string `xml:"FMTVersion" json:"fmt_version,omitempty"`
+	SNOMEDVersion string `xml:"SNOMEDVersion,omitempty" json:"snomed_version,omitempty"`
+	FMTVersion    string `xml:"FMTVersion,omitempty" json:"fmt_version,omitempty"`
 }
 
 type Instruction struct {
@@ -474,3 +1015,43 @@ func (t *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	t.Time = parsed
 	return nil
 }
+
+// MarshalJSON writes the date as an RFC 3339 timestamp, as ToJson always has.
+func (t Date) MarshalJSON() ([]byte, error) {
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON accepts the RFC 3339 timestamps written by MarshalJSON as
+// well as plain "2006-01-02" dates and null.
+func (t *Date) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == nil || *s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		const format = "2006-01-02"
+		parsed, err = time.Parse(format, *s)
+		if err != nil {
+			return err
+		}
+	}
+
+	t.Time = parsed
+	return nil
+}
+
+func (t Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if t.IsZero() {
+		return nil
+	}
+
+	const format = "2006-01-02"
+	return e.EncodeElement(t.Format(format), start)
+}
@@ -0,0 +1,425 @@
+package ncpdp
+
+import (
+	"io"
+	"sort"
+	"strings"
+)
+
+// NCIt codes of the subsets in NCPDPTerminology.txt.
+const (
+	SubsetDEASchedule           = "C89507"
+	SubsetDoseUnitOfMeasure     = "C121847"
+	SubsetMeasurementUnitCode   = "C91101"
+	SubsetQuantityUnitOfMeasure = "C89510"
+	SubsetStrengthForm          = "C89508"
+	SubsetStrengthUnitOfMeasure = "C89509"
+)
+
+var subsetCodes = map[string]string{
+	"DEASchedule":           SubsetDEASchedule,
+	"DoseUnitOfMeasure":     SubsetDoseUnitOfMeasure,
+	"MeasurementUnitCode":   SubsetMeasurementUnitCode,
+	"QuantityUnitOfMeasure": SubsetQuantityUnitOfMeasure,
+	"StrengthForm":          SubsetStrengthForm,
+	"StrengthUnitOfMeasure": SubsetStrengthUnitOfMeasure,
+}
+
+// SubsetCode resolves a subset name such as "QuantityUnitOfMeasure" or
+// "NCPDP QuantityUnitOfMeasure Terminology" to its NCIt code. Codes are
+// returned unchanged and unknown names return "".
+func SubsetCode(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "NCPDP "), " Terminology")
+	if code, ok := subsetCodes[name]; ok {
+		return code
+	}
+
+	for _, code := range subsetCodes {
+		if code == name {
+			return code
+		}
+	}
+
+	return ""
+}
+
+// FindInSubset returns a copy of the entry for an NCIt code within a subset,
+// or nil.
+func (t Terminologies) FindInSubset(subsetCode, code string) *Terminology {
+	for i := range t {
+		if t[i].NCItSubsetCode == subsetCode && t[i].NCItCode == code {
+			return copyTerm(t[i])
+		}
+	}
+
+	return nil
+}
+
+// Subset returns copies of the entries of the subset named by name, see
+// SubsetCode.
+func (t Terminologies) Subset(name string) []*Terminology {
+	code := SubsetCode(name)
+	if code == "" {
+		return nil
+	}
+
+	var terms []*Terminology
+	for i := range t {
+		if t[i].NCItSubsetCode == code {
+			terms = append(terms, copyTerm(t[i]))
+		}
+	}
+
+	return terms
+}
+
+func (t Terminologies) FindDEASchedule(code string) *Terminology {
+	return t.FindInSubset(SubsetDEASchedule, code)
+}
+
+func (t Terminologies) FindDoseUnitOfMeasure(code string) *Terminology {
+	return t.FindInSubset(SubsetDoseUnitOfMeasure, code)
+}
+
+func (t Terminologies) FindMeasurementUnitCode(code string) *Terminology {
+	return t.FindInSubset(SubsetMeasurementUnitCode, code)
+}
+
+func (t Terminologies) FindQuantityUnitOfMeasure(code string) *Terminology {
+	return t.FindInSubset(SubsetQuantityUnitOfMeasure, code)
+}
+
+func (t Terminologies) FindStrengthForm(code string) *Terminology {
+	return t.FindInSubset(SubsetStrengthForm, code)
+}
+
+func (t Terminologies) FindStrengthUnitOfMeasure(code string) *Terminology {
+	return t.FindInSubset(SubsetStrengthUnitOfMeasure, code)
+}
+
+// TerminologyIndex is a read-only index over Terminologies. It is built once
+// and is safe for concurrent use by multiple goroutines.
+type TerminologyIndex struct {
+	terms    Terminologies
+	byCode   map[string][]*Terminology
+	bySubset map[string]map[string]*Terminology
+	subsets  map[string][]*Terminology
+	byTerm   map[string][]*Terminology
+}
+
+// NewTerminologyIndex indexes t by NCIt code, by subset and code, and by
+// preferred term, synonym and NCIt preferred term. The first record of t is
+// the header row of the terminology file, as returned by LoadTerminology, and
+// is skipped.
+func NewTerminologyIndex(t *Terminologies) *TerminologyIndex {
+	idx := &TerminologyIndex{
+		byCode:   map[string][]*Terminology{},
+		bySubset: map[string]map[string]*Terminology{},
+		subsets:  map[string][]*Terminology{},
+		byTerm:   map[string][]*Terminology{},
+	}
+
+	if t == nil {
+		return idx
+	}
+
+	for i, term := range *t {
+		if i == 0 || term == nil {
+			continue
+		}
+
+		idx.terms = append(idx.terms, term)
+		idx.byCode[term.NCItCode] = append(idx.byCode[term.NCItCode], term)
+
+		subset := idx.bySubset[term.NCItSubsetCode]
+		if subset == nil {
+			subset = map[string]*Terminology{}
+			idx.bySubset[term.NCItSubsetCode] = subset
+		}
+		subset[term.NCItCode] = term
+		idx.subsets[term.NCItSubsetCode] = append(idx.subsets[term.NCItSubsetCode], term)
+
+		seen := map[string]bool{}
+		for _, s := range []string{term.PreferredTerm, term.Synonym, term.NCItPreferredTerm} {
+			key := strings.ToLower(strings.TrimSpace(s))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			idx.byTerm[key] = append(idx.byTerm[key], term)
+		}
+	}
+
+	return idx
+}
+
+// LoadTerminologyIndex loads the terminology from r, or the embedded file
+// when r is nil, and indexes it.
+func LoadTerminologyIndex(r io.Reader) (*TerminologyIndex, error) {
+	terms, err := LoadTerminology(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTerminologyIndex(terms), nil
+}
+
+func (idx *TerminologyIndex) Len() int {
+	return len(idx.terms)
+}
+
+// FindByCode returns copies of every subset entry for an NCIt code.
+func (idx *TerminologyIndex) FindByCode(code string) []*Terminology {
+	return copyTerms(idx.byCode[code])
+}
+
+// Find returns a copy of the entry for an NCIt code within a subset, or nil.
+func (idx *TerminologyIndex) Find(subsetCode, code string) *Terminology {
+	return copyTerm(idx.bySubset[subsetCode][code])
+}
+
+func (idx *TerminologyIndex) FindInSubset(subsetCode, code string) *Terminology {
+	return idx.Find(subsetCode, code)
+}
+
+// Subset returns copies of the entries of the subset named by name, see
+// SubsetCode.
+func (idx *TerminologyIndex) Subset(name string) []*Terminology {
+	return copyTerms(idx.subsets[SubsetCode(name)])
+}
+
+func (idx *TerminologyIndex) FindDEASchedule(code string) *Terminology {
+	return idx.Find(SubsetDEASchedule, code)
+}
+
+func (idx *TerminologyIndex) FindDoseUnitOfMeasure(code string) *Terminology {
+	return idx.Find(SubsetDoseUnitOfMeasure, code)
+}
+
+func (idx *TerminologyIndex) FindMeasurementUnitCode(code string) *Terminology {
+	return idx.Find(SubsetMeasurementUnitCode, code)
+}
+
+func (idx *TerminologyIndex) FindQuantityUnitOfMeasure(code string) *Terminology {
+	return idx.Find(SubsetQuantityUnitOfMeasure, code)
+}
+
+func (idx *TerminologyIndex) FindStrengthForm(code string) *Terminology {
+	return idx.Find(SubsetStrengthForm, code)
+}
+
+func (idx *TerminologyIndex) FindStrengthUnitOfMeasure(code string) *Terminology {
+	return idx.Find(SubsetStrengthUnitOfMeasure, code)
+}
+
+// FindByTerm returns copies of the entries whose preferred term, synonym or
+// NCIt preferred term equals term, ignoring case.
+func (idx *TerminologyIndex) FindByTerm(term string) []*Terminology {
+	return copyTerms(idx.byTerm[strings.ToLower(strings.TrimSpace(term))])
+}
+
+// copyTerm returns a copy of term so callers cannot modify indexed entries.
+func copyTerm(term *Terminology) *Terminology {
+	if term == nil {
+		return nil
+	}
+
+	c := *term
+	return &c
+}
+
+func copyTerms(terms []*Terminology) []*Terminology {
+	if terms == nil {
+		return nil
+	}
+
+	c := make([]*Terminology, len(terms))
+	for i, term := range terms {
+		c[i] = copyTerm(term)
+	}
+
+	return c
+}
+
+// FindTermByQuantityUnitOfMeasureCode mirrors the Terminologies method of the
+// same name.
+//
+// Deprecated: use FindQuantityUnitOfMeasure.
+func (idx *TerminologyIndex) FindTermByQuantityUnitOfMeasureCode(code string) string {
+	if term := idx.bySubset[SubsetQuantityUnitOfMeasure][code]; term != nil {
+		return term.PreferredTerm
+	}
+
+	return ""
+}
+
+// MatchQuality ranks the result of a free text terminology lookup; lower
+// values are better matches.
+type MatchQuality int
+
+const (
+	MatchPreferredTerm MatchQuality = iota + 1
+	MatchSynonym
+	MatchNCItPreferredTerm
+	MatchNormalized
+	MatchWord
+)
+
+type TermMatch struct {
+	Term    *Terminology
+	Quality MatchQuality
+}
+
+// abbreviations maps common free text units onto NCPDP preferred terms.
+var abbreviations = map[string]string{
+	"tab":    "tablet",
+	"cap":    "capsule",
+	"ml":     "milliliter",
+	"cc":     "milliliter",
+	"l":      "liter",
+	"mg":     "milligram",
+	"mcg":    "microgram",
+	"ug":     "microgram",
+	"µg":     "microgram",
+	"g":      "gram",
+	"gm":     "gram",
+	"kg":     "kilogram",
+	"meq":    "milliequivalent",
+	"oz":     "ounce",
+	"fl oz":  "fluid ounce",
+	"gal":    "gallon",
+	"pt":     "pint",
+	"qt":     "quart",
+	"ea":     "each",
+	"supp":   "suppository",
+	"pkt":    "packet",
+	"inh":    "inhalation",
+	"inj":    "injection",
+	"u":      "unit",
+	"iu":     "international unit",
+	"gtt":    "metric drop",
+	"drop":   "metric drop",
+	"cm":     "centimeter",
+	"in":     "inch",
+	"lb":     "pound",
+	"bpm":    "beats per minute",
+	"mmhg":   "millimeter of mercury",
+	"%":      "percentage",
+	"deg c":  "degree celsius",
+	"deg f":  "degree fahrenheit",
+	"applic": "applicator",
+}
+
+// normalizeTerm lower cases s, collapses punctuation and whitespace,
+// singularizes the last word and expands abbreviations.
+func normalizeTerm(s string) string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer("-", " ", ".", " ", ",", " ", "(", " ", ")", " ").Replace(s)
+
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return ""
+	}
+
+	if a, ok := abbreviations[strings.Join(words, " ")]; ok {
+		return a
+	}
+
+	last := words[len(words)-1]
+	switch {
+	case len(last) > 4 && (strings.HasSuffix(last, "ches") || strings.HasSuffix(last, "shes") ||
+		strings.HasSuffix(last, "sses") || strings.HasSuffix(last, "xes")):
+		last = last[:len(last)-2]
+	case len(last) > 3 && strings.HasSuffix(last, "ies"):
+		last = last[:len(last)-3] + "y"
+	case len(last) > 1 && strings.HasSuffix(last, "s") && !strings.HasSuffix(last, "ss"):
+		last = last[:len(last)-1]
+	}
+	words[len(words)-1] = last
+
+	s = strings.Join(words, " ")
+	if a, ok := abbreviations[s]; ok {
+		return a
+	}
+
+	return s
+}
+
+// matchTerm rates how well text describes term, or returns 0.
+func matchTerm(term *Terminology, text, normalized string) MatchQuality {
+	lower := strings.ToLower(strings.TrimSpace(text))
+	switch lower {
+	case strings.ToLower(term.PreferredTerm):
+		return MatchPreferredTerm
+	case strings.ToLower(term.Synonym):
+		if term.Synonym != "" {
+			return MatchSynonym
+		}
+	case strings.ToLower(term.NCItPreferredTerm):
+		return MatchNCItPreferredTerm
+	}
+
+	if normalized == "" {
+		return 0
+	}
+
+	names := []string{term.PreferredTerm, term.Synonym, term.NCItPreferredTerm}
+	for _, name := range names {
+		if name != "" && normalizeTerm(name) == normalized {
+			return MatchNormalized
+		}
+	}
+
+	if strings.Contains(normalized, " ") {
+		return 0
+	}
+
+	for _, name := range names {
+		for _, word := range strings.Fields(normalizeTerm(name)) {
+			if word == normalized {
+				return MatchWord
+			}
+		}
+	}
+
+	return 0
+}
+
+func findByText(terms []*Terminology, text string) []TermMatch {
+	normalized := normalizeTerm(text)
+
+	var matches []TermMatch
+	for _, term := range terms {
+		if q := matchTerm(term, text, normalized); q > 0 {
+			matches = append(matches, TermMatch{Term: copyTerm(term), Quality: q})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Quality != matches[j].Quality {
+			return matches[i].Quality < matches[j].Quality
+		}
+		return matches[i].Term.PreferredTerm < matches[j].Term.PreferredTerm
+	})
+
+	return matches
+}
+
+// FindByText maps free text such as "Tablet", "tabs" or "mL" to the entries
+// of a subset it may describe, best match first. Matching ignores case and
+// normalizes plurals and common abbreviations.
+func (t Terminologies) FindByText(subsetCode, text string) []TermMatch {
+	var subset []*Terminology
+	for i := range t {
+		if t[i].NCItSubsetCode == subsetCode {
+			subset = append(subset, t[i])
+		}
+	}
+
+	return findByText(subset, text)
+}
+
+func (idx *TerminologyIndex) FindByText(subsetCode, text string) []TermMatch {
+	return findByText(idx.subsets[subsetCode], text)
+}
@@ -0,0 +1,1257 @@
+// Code generated by gentermcodes from NCPDPTerminology.txt; DO NOT EDIT.
+
+package ncpdp
+
+// DEAScheduleCode is an NCIt code from the DEASchedule terminology subset.
+type DEAScheduleCode string
+
+const (
+	DEAScheduleScheduleISubstance   DEAScheduleCode = "C48672"
+	DEAScheduleScheduleIISubstance  DEAScheduleCode = "C48675"
+	DEAScheduleScheduleIIISubstance DEAScheduleCode = "C48676"
+	DEAScheduleScheduleIVSubstance  DEAScheduleCode = "C48677"
+	DEAScheduleScheduleVSubstance   DEAScheduleCode = "C48679"
+	DEAScheduleUnspecified          DEAScheduleCode = "C38046"
+)
+
+var deaScheduleTerms = map[DEAScheduleCode]string{
+	DEAScheduleScheduleISubstance:   "Schedule I Substance",
+	DEAScheduleScheduleIISubstance:  "Schedule II Substance",
+	DEAScheduleScheduleIIISubstance: "Schedule III Substance",
+	DEAScheduleScheduleIVSubstance:  "Schedule IV Substance",
+	DEAScheduleScheduleVSubstance:   "Schedule V Substance",
+	DEAScheduleUnspecified:          "Unspecified",
+}
+
+// String returns the NCPDP preferred term, or the code itself when it is
+// not in the subset.
+func (c DEAScheduleCode) String() string {
+	if term, ok := deaScheduleTerms[c]; ok {
+		return term
+	}
+
+	return string(c)
+}
+
+func (c DEAScheduleCode) Valid() bool {
+	_, ok := deaScheduleTerms[c]
+	return ok
+}
+
+func (c DEAScheduleCode) Subset() string {
+	return SubsetDEASchedule
+}
+
+// DoseUnitCode is an NCIt code from the DoseUnitOfMeasure terminology subset.
+type DoseUnitCode string
+
+const (
+	DoseUnitActuation           DoseUnitCode = "C122629"
+	DoseUnitAmpule              DoseUnitCode = "C48473"
+	DoseUnitApplication         DoseUnitCode = "C25397"
+	DoseUnitApplicator          DoseUnitCode = "C62412"
+	DoseUnitAutoInjector        DoseUnitCode = "C122630"
+	DoseUnitBar                 DoseUnitCode = "C48475"
+	DoseUnitCapful              DoseUnitCode = "C102405"
+	DoseUnitCaplet              DoseUnitCode = "C64696"
+	DoseUnitCapsule             DoseUnitCode = "C48480"
+	DoseUnitCartridge           DoseUnitCode = "C48481"
+	DoseUnitCentimeter          DoseUnitCode = "C49668"
+	DoseUnitDisk                DoseUnitCode = "C48490"
+	DoseUnitDropperful          DoseUnitCode = "C122631"
+	DoseUnitEach                DoseUnitCode = "C64933"
+	DoseUnitFilm                DoseUnitCode = "C53499"
+	DoseUnitFluidOunce          DoseUnitCode = "C48494"
+	DoseUnitGallon              DoseUnitCode = "C48580"
+	DoseUnitGram                DoseUnitCode = "C48155"
+	DoseUnitGum                 DoseUnitCode = "C69124"
+	DoseUnitImplant             DoseUnitCode = "C48499"
+	DoseUnitInch                DoseUnitCode = "C48500"
+	DoseUnitInchStrip           DoseUnitCode = "C124231"
+	DoseUnitInhalation          DoseUnitCode = "C48501"
+	DoseUnitInjection           DoseUnitCode = "C122632"
+	DoseUnitInsert              DoseUnitCode = "C62276"
+	DoseUnitLiter               DoseUnitCode = "C48505"
+	DoseUnitLollipop            DoseUnitCode = "C122633"
+	DoseUnitLozenge             DoseUnitCode = "C48506"
+	DoseUnitMetricDrop          DoseUnitCode = "C48491"
+	DoseUnitMicrogram           DoseUnitCode = "C48152"
+	DoseUnitMilliequivalent     DoseUnitCode = "C48512"
+	DoseUnitMilligram           DoseUnitCode = "C28253"
+	DoseUnitMilliliter          DoseUnitCode = "C28254"
+	DoseUnitMilliUnit           DoseUnitCode = "C67315"
+	DoseUnitNebule              DoseUnitCode = "C71204"
+	DoseUnitOunce               DoseUnitCode = "C48519"
+	DoseUnitPackage             DoseUnitCode = "C48520"
+	DoseUnitPacket              DoseUnitCode = "C48521"
+	DoseUnitPad                 DoseUnitCode = "C65032"
+	DoseUnitPatch               DoseUnitCode = "C48524"
+	DoseUnitPellet              DoseUnitCode = "C48525"
+	DoseUnitPill                DoseUnitCode = "C122634"
+	DoseUnitPint                DoseUnitCode = "C48529"
+	DoseUnitPreFilledPenSyringe DoseUnitCode = "C122635"
+	DoseUnitPuff                DoseUnitCode = "C65060"
+	DoseUnitPump                DoseUnitCode = "C111984"
+	DoseUnitQuart               DoseUnitCode = "C48534"
+	DoseUnitRing                DoseUnitCode = "C62609"
+	DoseUnitSachet              DoseUnitCode = "C71324"
+	DoseUnitScoopful            DoseUnitCode = "C48536"
+	DoseUnitSponge              DoseUnitCode = "C53502"
+	DoseUnitSpray               DoseUnitCode = "C48537"
+	DoseUnitStick               DoseUnitCode = "C53503"
+	DoseUnitStrip               DoseUnitCode = "C48538"
+	DoseUnitSuppository         DoseUnitCode = "C48539"
+	DoseUnitSwab                DoseUnitCode = "C53504"
+	DoseUnitSyringe             DoseUnitCode = "C48540"
+	DoseUnitTablet              DoseUnitCode = "C48542"
+	DoseUnitTroche              DoseUnitCode = "C48548"
+	DoseUnitUnit                DoseUnitCode = "C44278"
+	DoseUnitUnspecified         DoseUnitCode = "C38046"
+	DoseUnitVial                DoseUnitCode = "C48551"
+	DoseUnitWafer               DoseUnitCode = "C48552"
+)
+
+var doseUnitTerms = map[DoseUnitCode]string{
+	DoseUnitActuation:           "Actuation",
+	DoseUnitAmpule:              "Ampule",
+	DoseUnitApplication:         "Application",
+	DoseUnitApplicator:          "Applicator",
+	DoseUnitAutoInjector:        "Auto-Injector",
+	DoseUnitBar:                 "Bar",
+	DoseUnitCapful:              "Capful",
+	DoseUnitCaplet:              "Caplet",
+	DoseUnitCapsule:             "Capsule",
+	DoseUnitCartridge:           "Cartridge",
+	DoseUnitCentimeter:          "Centimeter",
+	DoseUnitDisk:                "Disk",
+	DoseUnitDropperful:          "Dropperful",
+	DoseUnitEach:                "Each",
+	DoseUnitFilm:                "Film",
+	DoseUnitFluidOunce:          "Fluid Ounce",
+	DoseUnitGallon:              "Gallon",
+	DoseUnitGram:                "Gram",
+	DoseUnitGum:                 "Gum",
+	DoseUnitImplant:             "Implant",
+	DoseUnitInch:                "Inch",
+	DoseUnitInchStrip:           "Inch Strip",
+	DoseUnitInhalation:          "Inhalation",
+	DoseUnitInjection:           "Injection",
+	DoseUnitInsert:              "Insert",
+	DoseUnitLiter:               "Liter",
+	DoseUnitLollipop:            "Lollipop",
+	DoseUnitLozenge:             "Lozenge",
+	DoseUnitMetricDrop:          "Metric Drop",
+	DoseUnitMicrogram:           "Microgram",
+	DoseUnitMilliequivalent:     "Milliequivalent",
+	DoseUnitMilligram:           "Milligram",
+	DoseUnitMilliliter:          "Milliliter",
+	DoseUnitMilliUnit:           "MilliUnit",
+	DoseUnitNebule:              "Nebule",
+	DoseUnitOunce:               "Ounce",
+	DoseUnitPackage:             "Package",
+	DoseUnitPacket:              "Packet",
+	DoseUnitPad:                 "Pad",
+	DoseUnitPatch:               "Patch",
+	DoseUnitPellet:              "Pellet",
+	DoseUnitPill:                "Pill",
+	DoseUnitPint:                "Pint",
+	DoseUnitPreFilledPenSyringe: "Pre-Filled Pen Syringe",
+	DoseUnitPuff:                "Puff",
+	DoseUnitPump:                "Pump",
+	DoseUnitQuart:               "Quart",
+	DoseUnitRing:                "Ring",
+	DoseUnitSachet:              "Sachet",
+	DoseUnitScoopful:            "Scoopful",
+	DoseUnitSponge:              "Sponge",
+	DoseUnitSpray:               "Spray",
+	DoseUnitStick:               "Stick",
+	DoseUnitStrip:               "Strip",
+	DoseUnitSuppository:         "Suppository",
+	DoseUnitSwab:                "Swab",
+	DoseUnitSyringe:             "Syringe",
+	DoseUnitTablet:              "Tablet",
+	DoseUnitTroche:              "Troche",
+	DoseUnitUnit:                "Unit",
+	DoseUnitUnspecified:         "Unspecified",
+	DoseUnitVial:                "Vial",
+	DoseUnitWafer:               "Wafer",
+}
+
+// String returns the NCPDP preferred term, or the code itself when it is
+// not in the subset.
+func (c DoseUnitCode) String() string {
+	if term, ok := doseUnitTerms[c]; ok {
+		return term
+	}
+
+	return string(c)
+}
+
+func (c DoseUnitCode) Valid() bool {
+	_, ok := doseUnitTerms[c]
+	return ok
+}
+
+func (c DoseUnitCode) Subset() string {
+	return SubsetDoseUnitOfMeasure
+}
+
+// MeasurementUnitCode is an NCIt code from the MeasurementUnitCode terminology subset.
+type MeasurementUnitCode string
+
+const (
+	MeasurementUnitAgeMonths                 MeasurementUnitCode = "C37907"
+	MeasurementUnitAgeYears                  MeasurementUnitCode = "C37908"
+	MeasurementUnitBeatsPerMinute            MeasurementUnitCode = "C49673"
+	MeasurementUnitBodyMassIndex             MeasurementUnitCode = "C16358"
+	MeasurementUnitBodySurfaceArea           MeasurementUnitCode = "C25157"
+	MeasurementUnitBodyWeight                MeasurementUnitCode = "C81328"
+	MeasurementUnitBreathsPerMinute          MeasurementUnitCode = "C49674"
+	MeasurementUnitCentimeter                MeasurementUnitCode = "C49668"
+	MeasurementUnitDay                       MeasurementUnitCode = "C25301"
+	MeasurementUnitDegreeCelsius             MeasurementUnitCode = "C42559"
+	MeasurementUnitDegreeFahrenheit          MeasurementUnitCode = "C44277"
+	MeasurementUnitDiastolicBloodPressure    MeasurementUnitCode = "C25299"
+	MeasurementUnitGram                      MeasurementUnitCode = "C48155"
+	MeasurementUnitHeartRate                 MeasurementUnitCode = "C49677"
+	MeasurementUnitHeight                    MeasurementUnitCode = "C25347"
+	MeasurementUnitInch                      MeasurementUnitCode = "C48500"
+	MeasurementUnitKilogram                  MeasurementUnitCode = "C28252"
+	MeasurementUnitMeanArterialPressure      MeasurementUnitCode = "C49679"
+	MeasurementUnitMillimeterOfMercury       MeasurementUnitCode = "C49670"
+	MeasurementUnitMonth                     MeasurementUnitCode = "C29846"
+	MeasurementUnitPercentage                MeasurementUnitCode = "C25613"
+	MeasurementUnitPound                     MeasurementUnitCode = "C48531"
+	MeasurementUnitPulseRate                 MeasurementUnitCode = "C49676"
+	MeasurementUnitRespiratoryRate           MeasurementUnitCode = "C49678"
+	MeasurementUnitSagittalAbdominalDiameter MeasurementUnitCode = "C87054"
+	MeasurementUnitSquareMeter               MeasurementUnitCode = "C42569"
+	MeasurementUnitSystolicBloodPressure     MeasurementUnitCode = "C25298"
+	MeasurementUnitTemperature               MeasurementUnitCode = "C25206"
+	MeasurementUnitUnspecified               MeasurementUnitCode = "C38046"
+	MeasurementUnitYear                      MeasurementUnitCode = "C29848"
+)
+
+var measurementUnitTerms = map[MeasurementUnitCode]string{
+	MeasurementUnitAgeMonths:                 "Age-Months",
+	MeasurementUnitAgeYears:                  "Age-Years",
+	MeasurementUnitBeatsPerMinute:            "Beats per Minute",
+	MeasurementUnitBodyMassIndex:             "Body Mass Index",
+	MeasurementUnitBodySurfaceArea:           "Body Surface Area",
+	MeasurementUnitBodyWeight:                "Body Weight",
+	MeasurementUnitBreathsPerMinute:          "Breaths per Minute",
+	MeasurementUnitCentimeter:                "Centimeter",
+	MeasurementUnitDay:                       "Day",
+	MeasurementUnitDegreeCelsius:             "Degree Celsius",
+	MeasurementUnitDegreeFahrenheit:          "Degree Fahrenheit",
+	MeasurementUnitDiastolicBloodPressure:    "Diastolic Blood Pressure",
+	MeasurementUnitGram:                      "Gram",
+	MeasurementUnitHeartRate:                 "Heart Rate",
+	MeasurementUnitHeight:                    "Height",
+	MeasurementUnitInch:                      "Inch",
+	MeasurementUnitKilogram:                  "Kilogram",
+	MeasurementUnitMeanArterialPressure:      "Mean Arterial Pressure",
+	MeasurementUnitMillimeterOfMercury:       "Millimeter of Mercury",
+	MeasurementUnitMonth:                     "Month",
+	MeasurementUnitPercentage:                "Percentage",
+	MeasurementUnitPound:                     "Pound",
+	MeasurementUnitPulseRate:                 "Pulse Rate",
+	MeasurementUnitRespiratoryRate:           "Respiratory Rate",
+	MeasurementUnitSagittalAbdominalDiameter: "Sagittal Abdominal Diameter",
+	MeasurementUnitSquareMeter:               "Square Meter",
+	MeasurementUnitSystolicBloodPressure:     "Systolic Blood Pressure",
+	MeasurementUnitTemperature:               "Temperature",
+	MeasurementUnitUnspecified:               "Unspecified",
+	MeasurementUnitYear:                      "Year",
+}
+
+// String returns the NCPDP preferred term, or the code itself when it is
+// not in the subset.
+func (c MeasurementUnitCode) String() string {
+	if term, ok := measurementUnitTerms[c]; ok {
+		return term
+	}
+
+	return string(c)
+}
+
+func (c MeasurementUnitCode) Valid() bool {
+	_, ok := measurementUnitTerms[c]
+	return ok
+}
+
+func (c MeasurementUnitCode) Subset() string {
+	return SubsetMeasurementUnitCode
+}
+
+// QuantityUnitCode is an NCIt code from the QuantityUnitOfMeasure terminology subset.
+type QuantityUnitCode string
+
+const (
+	QuantityUnitApplicator  QuantityUnitCode = "C62412"
+	QuantityUnitBlister     QuantityUnitCode = "C54564"
+	QuantityUnitCaplet      QuantityUnitCode = "C64696"
+	QuantityUnitCapsule     QuantityUnitCode = "C48480"
+	QuantityUnitEach        QuantityUnitCode = "C64933"
+	QuantityUnitFilm        QuantityUnitCode = "C53499"
+	QuantityUnitGram        QuantityUnitCode = "C48155"
+	QuantityUnitGum         QuantityUnitCode = "C69124"
+	QuantityUnitImplant     QuantityUnitCode = "C48499"
+	QuantityUnitInsert      QuantityUnitCode = "C62276"
+	QuantityUnitKit         QuantityUnitCode = "C48504"
+	QuantityUnitLancet      QuantityUnitCode = "C120263"
+	QuantityUnitLozenge     QuantityUnitCode = "C48506"
+	QuantityUnitMilliliter  QuantityUnitCode = "C28254"
+	QuantityUnitPacket      QuantityUnitCode = "C48521"
+	QuantityUnitPad         QuantityUnitCode = "C65032"
+	QuantityUnitPatch       QuantityUnitCode = "C48524"
+	QuantityUnitPenNeedle   QuantityUnitCode = "C120216"
+	QuantityUnitRing        QuantityUnitCode = "C62609"
+	QuantityUnitSponge      QuantityUnitCode = "C53502"
+	QuantityUnitStick       QuantityUnitCode = "C53503"
+	QuantityUnitStrip       QuantityUnitCode = "C48538"
+	QuantityUnitSuppository QuantityUnitCode = "C48539"
+	QuantityUnitSwab        QuantityUnitCode = "C53504"
+	QuantityUnitTablet      QuantityUnitCode = "C48542"
+	QuantityUnitTroche      QuantityUnitCode = "C48548"
+	QuantityUnitUnspecified QuantityUnitCode = "C38046"
+	QuantityUnitWafer       QuantityUnitCode = "C48552"
+)
+
+var quantityUnitTerms = map[QuantityUnitCode]string{
+	QuantityUnitApplicator:  "Applicator",
+	QuantityUnitBlister:     "Blister",
+	QuantityUnitCaplet:      "Caplet",
+	QuantityUnitCapsule:     "Capsule",
+	QuantityUnitEach:        "Each",
+	QuantityUnitFilm:        "Film",
+	QuantityUnitGram:        "Gram",
+	QuantityUnitGum:         "Gum",
+	QuantityUnitImplant:     "Implant",
+	QuantityUnitInsert:      "Insert",
+	QuantityUnitKit:         "Kit",
+	QuantityUnitLancet:      "Lancet",
+	QuantityUnitLozenge:     "Lozenge",
+	QuantityUnitMilliliter:  "Milliliter",
+	QuantityUnitPacket:      "Packet",
+	QuantityUnitPad:         "Pad",
+	QuantityUnitPatch:       "Patch",
+	QuantityUnitPenNeedle:   "Pen Needle",
+	QuantityUnitRing:        "Ring",
+	QuantityUnitSponge:      "Sponge",
+	QuantityUnitStick:       "Stick",
+	QuantityUnitStrip:       "Strip",
+	QuantityUnitSuppository: "Suppository",
+	QuantityUnitSwab:        "Swab",
+	QuantityUnitTablet:      "Tablet",
+	QuantityUnitTroche:      "Troche",
+	QuantityUnitUnspecified: "Unspecified",
+	QuantityUnitWafer:       "Wafer",
+}
+
+// String returns the NCPDP preferred term, or the code itself when it is
+// not in the subset.
+func (c QuantityUnitCode) String() string {
+	if term, ok := quantityUnitTerms[c]; ok {
+		return term
+	}
+
+	return string(c)
+}
+
+func (c QuantityUnitCode) Valid() bool {
+	_, ok := quantityUnitTerms[c]
+	return ok
+}
+
+func (c QuantityUnitCode) Subset() string {
+	return SubsetQuantityUnitOfMeasure
+}
+
+// StrengthFormCode is an NCIt code from the StrengthForm terminology subset.
+type StrengthFormCode string
+
+const (
+	StrengthForm21DayTablet                                             StrengthFormCode = "C78746"
+	StrengthForm28DayTablet                                             StrengthFormCode = "C78747"
+	StrengthFormAdjustableDosePreFilledPenSyringe                       StrengthFormCode = "C107670"
+	StrengthFormAdultSuppository                                        StrengthFormCode = "C64886"
+	StrengthFormAerosol                                                 StrengthFormCode = "C42887"
+	StrengthFormAerosolFoam                                             StrengthFormCode = "C42888"
+	StrengthFormAerosolMist                                             StrengthFormCode = "C68935"
+	StrengthFormAerosolSolution                                         StrengthFormCode = "C69030"
+	StrengthFormAerosolSpray                                            StrengthFormCode = "C42889"
+	StrengthFormAugmentedCream                                          StrengthFormCode = "C60897"
+	StrengthFormAugmentedGel                                            StrengthFormCode = "C91136"
+	StrengthFormAugmentedLotion                                         StrengthFormCode = "C60957"
+	StrengthFormAugmentedOintment                                       StrengthFormCode = "C60984"
+	StrengthFormAutoInjector                                            StrengthFormCode = "C91227"
+	StrengthFormBandage                                                 StrengthFormCode = "C69012"
+	StrengthFormBar                                                     StrengthFormCode = "C68950"
+	StrengthFormBarSoap                                                 StrengthFormCode = "C91137"
+	StrengthFormBead                                                    StrengthFormCode = "C42890"
+	StrengthFormBitingCapsule                                           StrengthFormCode = "C64875"
+	StrengthFormBlock                                                   StrengthFormCode = "C42891"
+	StrengthFormBuccalCapsule                                           StrengthFormCode = "C64874"
+	StrengthFormBuccalFilm                                              StrengthFormCode = "C91138"
+	StrengthFormBuccalTablet                                            StrengthFormCode = "C42755"
+	StrengthFormCachet                                                  StrengthFormCode = "C64878"
+	StrengthFormCake                                                    StrengthFormCode = "C68951"
+	StrengthFormCandy                                                   StrengthFormCode = "C64880"
+	StrengthFormCapsule                                                 StrengthFormCode = "C25158"
+	StrengthFormCapsule12HourSustainedRelease                           StrengthFormCode = "C68943"
+	StrengthFormCapsule24HourSustainedRelease                           StrengthFormCode = "C68944"
+	StrengthFormCement                                                  StrengthFormCode = "C45414"
+	StrengthFormChewableBar                                             StrengthFormCode = "C42892"
+	StrengthFormChewableCapsule                                         StrengthFormCode = "C64876"
+	StrengthFormChewableTablet                                          StrengthFormCode = "C42893"
+	StrengthFormChewingGum                                              StrengthFormCode = "C42894"
+	StrengthFormCigarette                                               StrengthFormCode = "C42678"
+	StrengthFormCloth                                                   StrengthFormCode = "C60884"
+	StrengthFormCoatedCapsule                                           StrengthFormCode = "C42895"
+	StrengthFormCoatedPelletInCapsule                                   StrengthFormCode = "C42896"
+	StrengthFormCoatedTablet                                            StrengthFormCode = "C42897"
+	StrengthFormCompressedSugarCoatedCaplet                             StrengthFormCode = "C69002"
+	StrengthFormConcentrated                                            StrengthFormCode = "C60891"
+	StrengthFormConcentratedInjectableSolution                          StrengthFormCode = "C42899"
+	StrengthFormConcentratedOral                                        StrengthFormCode = "C69001"
+	StrengthFormConcentratedSolution                                    StrengthFormCode = "C42898"
+	StrengthFormCone                                                    StrengthFormCode = "C42900"
+	StrengthFormControlledRelease                                       StrengthFormCode = "C42731"
+	StrengthFormControlledReleaseCapsule                                StrengthFormCode = "C69024"
+	StrengthFormControlledReleaseLiquid                                 StrengthFormCode = "C69026"
+	StrengthFormControlledReleaseTablet                                 StrengthFormCode = "C69025"
+	StrengthFormCream                                                   StrengthFormCode = "C28944"
+	StrengthFormCrystal                                                 StrengthFormCode = "C42901"
+	StrengthFormCube                                                    StrengthFormCode = "C64881"
+	StrengthFormCulture                                                 StrengthFormCode = "C45415"
+	StrengthFormDegradableControlledReleaseCapsule                      StrengthFormCode = "C68949"
+	StrengthFormDelayedRelease                                          StrengthFormCode = "C42730"
+	StrengthFormDelayedReleaseCapsule                                   StrengthFormCode = "C42902"
+	StrengthFormDelayedReleaseGranule                                   StrengthFormCode = "C42903"
+	StrengthFormDelayedReleaseParticleTablet                            StrengthFormCode = "C42997"
+	StrengthFormDelayedReleasePelletInCapsule                           StrengthFormCode = "C42904"
+	StrengthFormDelayedReleaseTablet                                    StrengthFormCode = "C42905"
+	StrengthFormDental                                                  StrengthFormCode = "C69059"
+	StrengthFormDentalCone                                              StrengthFormCode = "C68954"
+	StrengthFormDentalLiner                                             StrengthFormCode = "C45413"
+	StrengthFormDentifriceGel                                           StrengthFormCode = "C42906"
+	StrengthFormDentifricePaste                                         StrengthFormCode = "C42907"
+	StrengthFormDentifricePowder                                        StrengthFormCode = "C42908"
+	StrengthFormDiffusionControlledExtendedRelease                      StrengthFormCode = "C42740"
+	StrengthFormDisc                                                    StrengthFormCode = "C43525"
+	StrengthFormDisintegratingTablet                                    StrengthFormCode = "C69071"
+	StrengthFormDispensingTablet                                        StrengthFormCode = "C42756"
+	StrengthFormDissolutionControlledExtendedRelease                    StrengthFormCode = "C42741"
+	StrengthFormDouche                                                  StrengthFormCode = "C42679"
+	StrengthFormDouchePowder                                            StrengthFormCode = "C69033"
+	StrengthFormDoucheSolution                                          StrengthFormCode = "C69032"
+	StrengthFormDressing                                                StrengthFormCode = "C42763"
+	StrengthFormDrop                                                    StrengthFormCode = "C29012"
+	StrengthFormDropSolution                                            StrengthFormCode = "C60992"
+	StrengthFormDropSuspension                                          StrengthFormCode = "C60995"
+	StrengthFormDropSuspensionFinal                                     StrengthFormCode = "C68997"
+	StrengthFormDustingPowder                                           StrengthFormCode = "C64883"
+	StrengthFormEffervescentGranule                                     StrengthFormCode = "C42909"
+	StrengthFormEffervescentPowder                                      StrengthFormCode = "C64884"
+	StrengthFormEffervescentTablet                                      StrengthFormCode = "C42910"
+	StrengthFormElectricallyControlledExtendedReleasePatch              StrengthFormCode = "C42911"
+	StrengthFormElixir                                                  StrengthFormCode = "C42912"
+	StrengthFormEmulsion                                                StrengthFormCode = "C42913"
+	StrengthFormEmulsionForInjection                                    StrengthFormCode = "C42914"
+	StrengthFormEnema                                                   StrengthFormCode = "C42915"
+	StrengthFormEnemaPowder                                             StrengthFormCode = "C64885"
+	StrengthFormEnemaTablet                                             StrengthFormCode = "C64871"
+	StrengthFormEntericCoatedCapsule                                    StrengthFormCode = "C68945"
+	StrengthFormEntericCoatedTablet                                     StrengthFormCode = "C42758"
+	StrengthFormErosionControlledExtendedRelease                        StrengthFormCode = "C42742"
+	StrengthFormExtendedRelease                                         StrengthFormCode = "C42713"
+	StrengthFormExtendedReleaseBeadImplant                              StrengthFormCode = "C43451"
+	StrengthFormExtendedReleaseCapsule                                  StrengthFormCode = "C42916"
+	StrengthFormExtendedReleaseCoatedCapsule                            StrengthFormCode = "C42917"
+	StrengthFormExtendedReleaseCoatedPellet                             StrengthFormCode = "C42918"
+	StrengthFormExtendedReleaseCore                                     StrengthFormCode = "C42919"
+	StrengthFormExtendedReleaseEntericCoatedCapsule                     StrengthFormCode = "C91140"
+	StrengthFormExtendedReleaseEntericCoatedTablet                      StrengthFormCode = "C91141"
+	StrengthFormExtendedReleaseFiber                                    StrengthFormCode = "C60926"
+	StrengthFormExtendedReleaseFilm                                     StrengthFormCode = "C42920"
+	StrengthFormExtendedReleaseFilmCoatedCapsule                        StrengthFormCode = "C42928"
+	StrengthFormExtendedReleaseForSuspension                            StrengthFormCode = "C60929"
+	StrengthFormExtendedReleaseGelFormingSolution                       StrengthFormCode = "C42935"
+	StrengthFormExtendedReleaseGranule                                  StrengthFormCode = "C69067"
+	StrengthFormExtendedReleaseGranuleForSuspension                     StrengthFormCode = "C42921"
+	StrengthFormExtendedReleaseInsert                                   StrengthFormCode = "C42922"
+	StrengthFormExtendedReleaseLiquid                                   StrengthFormCode = "C60934"
+	StrengthFormExtendedReleasePatch                                    StrengthFormCode = "C42923"
+	StrengthFormExtendedReleaseSuppository                              StrengthFormCode = "C42924"
+	StrengthFormExtendedReleaseSuspension                               StrengthFormCode = "C42925"
+	StrengthFormExtendedReleaseTablet                                   StrengthFormCode = "C42927"
+	StrengthFormExtract                                                 StrengthFormCode = "C42929"
+	StrengthFormFilm                                                    StrengthFormCode = "C42932"
+	StrengthFormFilmCoatedExtendedReleaseTablet                         StrengthFormCode = "C42930"
+	StrengthFormFilmCoatedTablet                                        StrengthFormCode = "C42931"
+	StrengthFormFlake                                                   StrengthFormCode = "C68982"
+	StrengthFormFluidExtract                                            StrengthFormCode = "C68991"
+	StrengthFormFoam                                                    StrengthFormCode = "C64898"
+	StrengthFormFoamBath                                                StrengthFormCode = "C64899"
+	StrengthFormForSolution                                             StrengthFormCode = "C60927"
+	StrengthFormForSuspension                                           StrengthFormCode = "C60928"
+	StrengthFormFrozenPremixIntravenousPiggybackSolution                StrengthFormCode = "C68966"
+	StrengthFormGargle                                                  StrengthFormCode = "C78748"
+	StrengthFormGas                                                     StrengthFormCode = "C42933"
+	StrengthFormGel                                                     StrengthFormCode = "C42934"
+	StrengthFormGelFormingDropSolution                                  StrengthFormCode = "C60994"
+	StrengthFormGelFormingSolution                                      StrengthFormCode = "C68973"
+	StrengthFormGelatinCoatedCapsule                                    StrengthFormCode = "C42936"
+	StrengthFormGelatinCoatedTablet                                     StrengthFormCode = "C64872"
+	StrengthFormGenerator                                               StrengthFormCode = "C48193"
+	StrengthFormGlobule                                                 StrengthFormCode = "C42937"
+	StrengthFormGraft                                                   StrengthFormCode = "C45416"
+	StrengthFormGranule                                                 StrengthFormCode = "C42938"
+	StrengthFormGranuleForReconstitution                                StrengthFormCode = "C69066"
+	StrengthFormGranuleForSolution                                      StrengthFormCode = "C42939"
+	StrengthFormGranuleForSuspension                                    StrengthFormCode = "C42940"
+	StrengthFormGum                                                     StrengthFormCode = "C42941"
+	StrengthFormHardCapsule                                             StrengthFormCode = "C64904"
+	StrengthFormHomeopathicGlobule                                      StrengthFormCode = "C64882"
+	StrengthFormHypodermicTablet                                        StrengthFormCode = "C42752"
+	StrengthFormImmediateRelease                                        StrengthFormCode = "C42669"
+	StrengthFormImplant                                                 StrengthFormCode = "C42942"
+	StrengthFormImplantablePellet                                       StrengthFormCode = "C42943"
+	StrengthFormInhalant                                                StrengthFormCode = "C42944"
+	StrengthFormInhalantPowder                                          StrengthFormCode = "C91142"
+	StrengthFormInhalantSolution                                        StrengthFormCode = "C91143"
+	StrengthFormInhalerCapsule                                          StrengthFormCode = "C64879"
+	StrengthFormInjectable                                              StrengthFormCode = "C42946"
+	StrengthFormInjectableExtendedReleaseSuspension                     StrengthFormCode = "C42926"
+	StrengthFormInjectableLipidComplex                                  StrengthFormCode = "C42950"
+	StrengthFormInjectableLiposomalSuspension                           StrengthFormCode = "C42951"
+	StrengthFormInjectableLyophilizedPowder                             StrengthFormCode = "C69037"
+	StrengthFormInjectableSolution                                      StrengthFormCode = "C42945"
+	StrengthFormInjectableSonicatedSuspension                           StrengthFormCode = "C42988"
+	StrengthFormInjectableSuspension                                    StrengthFormCode = "C42995"
+	StrengthFormInsert                                                  StrengthFormCode = "C60933"
+	StrengthFormInternalPowder                                          StrengthFormCode = "C78793"
+	StrengthFormIntraperitonealSolution                                 StrengthFormCode = "C68971"
+	StrengthFormIntrauterineDevice                                      StrengthFormCode = "C47915"
+	StrengthFormIntravenousPiggybackSolution                            StrengthFormCode = "C68967"
+	StrengthFormIntravenousSolution                                     StrengthFormCode = "C68965"
+	StrengthFormIrrigant                                                StrengthFormCode = "C42947"
+	StrengthFormJelly                                                   StrengthFormCode = "C42948"
+	StrengthFormKit                                                     StrengthFormCode = "C47916"
+	StrengthFormLiniment                                                StrengthFormCode = "C42949"
+	StrengthFormLiposomalInjection                                      StrengthFormCode = "C60931"
+	StrengthFormLipstick                                                StrengthFormCode = "C42952"
+	StrengthFormLiquid                                                  StrengthFormCode = "C42953"
+	StrengthFormLiquidFilledCapsule                                     StrengthFormCode = "C42954"
+	StrengthFormLiquidSoap                                              StrengthFormCode = "C68953"
+	StrengthFormLollipop                                                StrengthFormCode = "C69068"
+	StrengthFormLotion                                                  StrengthFormCode = "C29167"
+	StrengthFormLotionShampoo                                           StrengthFormCode = "C60958"
+	StrengthFormLozenge                                                 StrengthFormCode = "C42955"
+	StrengthFormLyophilizedPowderForExtendedReleaseInjectableSuspension StrengthFormCode = "C42956"
+	StrengthFormLyophilizedPowderForInjectableLiposomalSuspension       StrengthFormCode = "C42959"
+	StrengthFormLyophilizedPowderForInjectableSolution                  StrengthFormCode = "C42957"
+	StrengthFormLyophilizedPowderForInjectableSuspension                StrengthFormCode = "C42958"
+	StrengthFormMedicatedAdhesivePatch                                  StrengthFormCode = "C68988"
+	StrengthFormMedicatedBarSoap                                        StrengthFormCode = "C91144"
+	StrengthFormMedicatedFilm                                           StrengthFormCode = "C68958"
+	StrengthFormMedicatedLiquidSoap                                     StrengthFormCode = "C91145"
+	StrengthFormMedicatedPad                                            StrengthFormCode = "C68957"
+	StrengthFormMedicatedShampoo                                        StrengthFormCode = "C91146"
+	StrengthFormMedicatedSoap                                           StrengthFormCode = "C68952"
+	StrengthFormMedicatedSponge                                         StrengthFormCode = "C64901"
+	StrengthFormMedicatedSwab                                           StrengthFormCode = "C68955"
+	StrengthFormMedicatedTape                                           StrengthFormCode = "C91147"
+	StrengthFormMembraneCoatedCapsule                                   StrengthFormCode = "C64877"
+	StrengthFormMembraneCoatedTablet                                    StrengthFormCode = "C64873"
+	StrengthFormMeteredAerosol                                          StrengthFormCode = "C42960"
+	StrengthFormMeteredDoseInhaler                                      StrengthFormCode = "C91148"
+	StrengthFormMeteredGel                                              StrengthFormCode = "C60930"
+	StrengthFormMeteredPowder                                           StrengthFormCode = "C42961"
+	StrengthFormMeteredSpray                                            StrengthFormCode = "C42962"
+	StrengthFormMicroEnema                                              StrengthFormCode = "C64888"
+	StrengthFormModifiedRelease                                         StrengthFormCode = "C42712"
+	StrengthFormMouthwash                                               StrengthFormCode = "C29269"
+	StrengthFormMucosalSpray                                            StrengthFormCode = "C91149"
+	StrengthFormMucousMembraneTopicalSolution                           StrengthFormCode = "C91150"
+	StrengthFormMultilayeredExtendedReleaseTablet                       StrengthFormCode = "C42963"
+	StrengthFormMultilayeredTablet                                      StrengthFormCode = "C42964"
+	StrengthFormNasal                                                   StrengthFormCode = "C69064"
+	StrengthFormNasalCream                                              StrengthFormCode = "C91151"
+	StrengthFormNasalGel                                                StrengthFormCode = "C91152"
+	StrengthFormNasalInhalant                                           StrengthFormCode = "C91153"
+	StrengthFormNasalInhaler                                            StrengthFormCode = "C91154"
+	StrengthFormNasalOintment                                           StrengthFormCode = "C91155"
+	StrengthFormNasalSolution                                           StrengthFormCode = "C91156"
+	StrengthFormNasalSpray                                              StrengthFormCode = "C91157"
+	StrengthFormNasalSuspension                                         StrengthFormCode = "C91158"
+	StrengthFormNonAerosolSpray                                         StrengthFormCode = "C68941"
+	StrengthFormNonMedicatedSwab                                        StrengthFormCode = "C69017"
+	StrengthFormNotApplicable                                           StrengthFormCode = "C48624"
+	StrengthFormOcularSystem                                            StrengthFormCode = "C69031"
+	StrengthFormOil                                                     StrengthFormCode = "C42965"
+	StrengthFormOintment                                                StrengthFormCode = "C42966"
+	StrengthFormOphthalmic                                              StrengthFormCode = "C69039"
+	StrengthFormOphthalmicCream                                         StrengthFormCode = "C91159"
+	StrengthFormOphthalmicGel                                           StrengthFormCode = "C91160"
+	StrengthFormOphthalmicIrrigationSolution                            StrengthFormCode = "C91161"
+	StrengthFormOphthalmicLiquid                                        StrengthFormCode = "C69038"
+	StrengthFormOphthalmicOintment                                      StrengthFormCode = "C91162"
+	StrengthFormOphthalmicSolution                                      StrengthFormCode = "C91163"
+	StrengthFormOphthalmicSuspension                                    StrengthFormCode = "C91164"
+	StrengthFormOral                                                    StrengthFormCode = "C42744"
+	StrengthFormOralCapsule                                             StrengthFormCode = "C91165"
+	StrengthFormOralCream                                               StrengthFormCode = "C91166"
+	StrengthFormOralFoam                                                StrengthFormCode = "C91167"
+	StrengthFormOralGel                                                 StrengthFormCode = "C91168"
+	StrengthFormOralOintment                                            StrengthFormCode = "C91169"
+	StrengthFormOralPaste                                               StrengthFormCode = "C91170"
+	StrengthFormOralPowder                                              StrengthFormCode = "C91171"
+	StrengthFormOralReconstitutedSuspension                             StrengthFormCode = "C68981"
+	StrengthFormOralSolution                                            StrengthFormCode = "C68996"
+	StrengthFormOralSpray                                               StrengthFormCode = "C91172"
+	StrengthFormOralStrip                                               StrengthFormCode = "C91173"
+	StrengthFormOralSuspension                                          StrengthFormCode = "C68992"
+	StrengthFormOralSuspensionFinal                                     StrengthFormCode = "C68993"
+	StrengthFormOralTablet                                              StrengthFormCode = "C43243"
+	StrengthFormOrallyDisintegratingDelayedReleaseTablet                StrengthFormCode = "C61006"
+	StrengthFormOrallyDisintegratingTablet                              StrengthFormCode = "C42999"
+	StrengthFormOsmoticLaserDrilledTablet                               StrengthFormCode = "C69008"
+	StrengthFormOsmoticPumpExtendedRelease                              StrengthFormCode = "C42760"
+	StrengthFormOtic                                                    StrengthFormCode = "C69040"
+	StrengthFormOticCream                                               StrengthFormCode = "C91174"
+	StrengthFormOticOintment                                            StrengthFormCode = "C91175"
+	StrengthFormOticSolution                                            StrengthFormCode = "C91176"
+	StrengthFormOticSuspension                                          StrengthFormCode = "C91177"
+	StrengthFormPack                                                    StrengthFormCode = "C62653"
+	StrengthFormPacking                                                 StrengthFormCode = "C47887"
+	StrengthFormPad                                                     StrengthFormCode = "C69016"
+	StrengthFormParenteral                                              StrengthFormCode = "C42746"
+	StrengthFormPaste                                                   StrengthFormCode = "C42967"
+	StrengthFormPastille                                                StrengthFormCode = "C60985"
+	StrengthFormPatch                                                   StrengthFormCode = "C42968"
+	StrengthFormPediatricLiquid                                         StrengthFormCode = "C69042"
+	StrengthFormPediatricSuppository                                    StrengthFormCode = "C64887"
+	StrengthFormPellet                                                  StrengthFormCode = "C42969"
+	StrengthFormPharmaceutical                                          StrengthFormCode = "C42636"
+	StrengthFormPill                                                    StrengthFormCode = "C25394"
+	StrengthFormPlaster                                                 StrengthFormCode = "C42970"
+	StrengthFormPolymericMicrosphere                                    StrengthFormCode = "C42736"
+	StrengthFormPoultice                                                StrengthFormCode = "C47913"
+	StrengthFormPowder                                                  StrengthFormCode = "C42972"
+	StrengthFormPowderAerosol                                           StrengthFormCode = "C42971"
+	StrengthFormPowderForInjectableExtendedReleaseSuspension            StrengthFormCode = "C42977"
+	StrengthFormPowderForInjectableSolution                             StrengthFormCode = "C42974"
+	StrengthFormPowderForInjectableSuspension                           StrengthFormCode = "C42976"
+	StrengthFormPowderForInjection                                      StrengthFormCode = "C69069"
+	StrengthFormPowderForOralSolution                                   StrengthFormCode = "C64907"
+	StrengthFormPowderForOralSuspension                                 StrengthFormCode = "C64908"
+	StrengthFormPowderForReconstitution                                 StrengthFormCode = "C69070"
+	StrengthFormPowderForSolution                                       StrengthFormCode = "C42973"
+	StrengthFormPowderForSuspension                                     StrengthFormCode = "C42975"
+	StrengthFormPowderInhaler                                           StrengthFormCode = "C91139"
+	StrengthFormPowderLikeNonEffervescentGranule                        StrengthFormCode = "C68983"
+	StrengthFormPrefilledApplicator                                     StrengthFormCode = "C91179"
+	StrengthFormPreFilledPenSyringe                                     StrengthFormCode = "C97717"
+	StrengthFormPrefilledSyringe                                        StrengthFormCode = "C91180"
+	StrengthFormPudding                                                 StrengthFormCode = "C68972"
+	StrengthFormReconstitutedOralDrop                                   StrengthFormCode = "C68984"
+	StrengthFormReconstitutedOralSolution                               StrengthFormCode = "C68985"
+	StrengthFormRectal                                                  StrengthFormCode = "C69046"
+	StrengthFormRectalCream                                             StrengthFormCode = "C69045"
+	StrengthFormRectalFoam                                              StrengthFormCode = "C91181"
+	StrengthFormRectalGel                                               StrengthFormCode = "C91182"
+	StrengthFormRectalOintment                                          StrengthFormCode = "C69047"
+	StrengthFormRectalPowder                                            StrengthFormCode = "C91183"
+	StrengthFormRectalSpray                                             StrengthFormCode = "C91184"
+	StrengthFormRectalSuppository                                       StrengthFormCode = "C68989"
+	StrengthFormResinGum                                                StrengthFormCode = "C42978"
+	StrengthFormRing                                                    StrengthFormCode = "C60988"
+	StrengthFormRinse                                                   StrengthFormCode = "C42979"
+	StrengthFormSalve                                                   StrengthFormCode = "C42980"
+	StrengthFormSemisolid                                               StrengthFormCode = "C45244"
+	StrengthFormShampoo                                                 StrengthFormCode = "C42981"
+	StrengthFormShampooSuspension                                       StrengthFormCode = "C42982"
+	StrengthFormSkinPatch                                               StrengthFormCode = "C28276"
+	StrengthFormSoap                                                    StrengthFormCode = "C42983"
+	StrengthFormSoftCapsule                                             StrengthFormCode = "C64909"
+	StrengthFormSolid                                                   StrengthFormCode = "C45235"
+	StrengthFormSolubleFilm                                             StrengthFormCode = "C42984"
+	StrengthFormSolubleTablet                                           StrengthFormCode = "C42985"
+	StrengthFormSolution                                                StrengthFormCode = "C42986"
+	StrengthFormSolutionForReconstitution                               StrengthFormCode = "C69028"
+	StrengthFormSolutionForSlush                                        StrengthFormCode = "C42987"
+	StrengthFormSponge                                                  StrengthFormCode = "C47912"
+	StrengthFormSpray                                                   StrengthFormCode = "C42989"
+	StrengthFormSpraySuspension                                         StrengthFormCode = "C42990"
+	StrengthFormSprinkleCapsule                                         StrengthFormCode = "C68946"
+	StrengthFormStick                                                   StrengthFormCode = "C42991"
+	StrengthFormStrip                                                   StrengthFormCode = "C47914"
+	StrengthFormSublingualTablet                                        StrengthFormCode = "C42751"
+	StrengthFormSugarCoatedTablet                                       StrengthFormCode = "C42992"
+	StrengthFormSuppository                                             StrengthFormCode = "C42993"
+	StrengthFormSuspension                                              StrengthFormCode = "C42994"
+	StrengthFormSuspension12HourSustainedRelease                        StrengthFormCode = "C68986"
+	StrengthFormSuspensionForReconstitution                             StrengthFormCode = "C69027"
+	StrengthFormSustainedRelease                                        StrengthFormCode = "C42672"
+	StrengthFormSustainedReleaseBuccalTablet                            StrengthFormCode = "C69007"
+	StrengthFormSustainedReleaseCapsule                                 StrengthFormCode = "C69043"
+	StrengthFormSustainedReleaseOralLiquid                              StrengthFormCode = "C69044"
+	StrengthFormSustainedReleaseParenteral                              StrengthFormCode = "C69036"
+	StrengthFormSustainedReleasePelletCapsule                           StrengthFormCode = "C68947"
+	StrengthFormSustainedReleaseTablet                                  StrengthFormCode = "C78751"
+	StrengthFormSuture                                                  StrengthFormCode = "C47889"
+	StrengthFormSwab                                                    StrengthFormCode = "C47898"
+	StrengthFormSyrup                                                   StrengthFormCode = "C42996"
+	StrengthFormTablet                                                  StrengthFormCode = "C42998"
+	StrengthFormTablet12HourSustainedRelease                            StrengthFormCode = "C69004"
+	StrengthFormTablet24HourSustainedRelease                            StrengthFormCode = "C69003"
+	StrengthFormTabletCoatedParticle                                    StrengthFormCode = "C60997"
+	StrengthFormTabletForSolution                                       StrengthFormCode = "C61004"
+	StrengthFormTabletForSuspension                                     StrengthFormCode = "C61005"
+	StrengthFormTabletParticleCrystal                                   StrengthFormCode = "C69006"
+	StrengthFormTabletTriturate                                         StrengthFormCode = "C42759"
+	StrengthFormTampon                                                  StrengthFormCode = "C47892"
+	StrengthFormTape                                                    StrengthFormCode = "C47897"
+	StrengthFormTar                                                     StrengthFormCode = "C78749"
+	StrengthFormThroatSpray                                             StrengthFormCode = "C69063"
+	StrengthFormTincture                                                StrengthFormCode = "C43000"
+	StrengthFormToothpaste                                              StrengthFormCode = "C91186"
+	StrengthFormTopical                                                 StrengthFormCode = "C42747"
+	StrengthFormTopicalCream                                            StrengthFormCode = "C91187"
+	StrengthFormTopicalFoam                                             StrengthFormCode = "C91188"
+	StrengthFormTopicalGel                                              StrengthFormCode = "C91189"
+	StrengthFormTopicalLotion                                           StrengthFormCode = "C91190"
+	StrengthFormTopicalOil                                              StrengthFormCode = "C91191"
+	StrengthFormTopicalOintment                                         StrengthFormCode = "C91192"
+	StrengthFormTopicalPowder                                           StrengthFormCode = "C91193"
+	StrengthFormTopicalPowderSpray                                      StrengthFormCode = "C91178"
+	StrengthFormTopicalSolution                                         StrengthFormCode = "C64905"
+	StrengthFormTopicalSpray                                            StrengthFormCode = "C91194"
+	StrengthFormTopicalSuspension                                       StrengthFormCode = "C68998"
+	StrengthFormTroche                                                  StrengthFormCode = "C43001"
+	StrengthFormUnassigned                                              StrengthFormCode = "C43002"
+	StrengthFormUnmedicatedSponge                                       StrengthFormCode = "C64902"
+	StrengthFormUnspecified                                             StrengthFormCode = "C38046"
+	StrengthFormUrethral                                                StrengthFormCode = "C69052"
+	StrengthFormUrethralGel                                             StrengthFormCode = "C91195"
+	StrengthFormUrethralSuppository                                     StrengthFormCode = "C69053"
+	StrengthFormVaginal                                                 StrengthFormCode = "C69048"
+	StrengthFormVaginalCream                                            StrengthFormCode = "C69050"
+	StrengthFormVaginalDiaphragm                                        StrengthFormCode = "C47890"
+	StrengthFormVaginalFoam                                             StrengthFormCode = "C69051"
+	StrengthFormVaginalGel                                              StrengthFormCode = "C91197"
+	StrengthFormVaginalOintment                                         StrengthFormCode = "C69054"
+	StrengthFormVaginalPowder                                           StrengthFormCode = "C91198"
+	StrengthFormVaginalRing                                             StrengthFormCode = "C91199"
+	StrengthFormVaginalSpray                                            StrengthFormCode = "C91200"
+	StrengthFormVaginalSuppository                                      StrengthFormCode = "C68990"
+	StrengthFormVaginalTablet                                           StrengthFormCode = "C69049"
+	StrengthFormWafer                                                   StrengthFormCode = "C43003"
+	StrengthFormWash                                                    StrengthFormCode = "C64903"
+	StrengthFormWax                                                     StrengthFormCode = "C78750"
+	StrengthFormWoundStick                                              StrengthFormCode = "C64910"
+)
+
+var strengthFormTerms = map[StrengthFormCode]string{
+	StrengthForm21DayTablet:                                "21 Day Tablet",
+	StrengthForm28DayTablet:                                "28 Day Tablet",
+	StrengthFormAdjustableDosePreFilledPenSyringe:          "Adjustable Dose Pre-filled Pen Syringe",
+	StrengthFormAdultSuppository:                           "Adult Suppository",
+	StrengthFormAerosol:                                    "Aerosol",
+	StrengthFormAerosolFoam:                                "Aerosol Foam",
+	StrengthFormAerosolMist:                                "Aerosol Mist",
+	StrengthFormAerosolSolution:                            "Aerosol Solution",
+	StrengthFormAerosolSpray:                               "Aerosol Spray",
+	StrengthFormAugmentedCream:                             "Augmented Cream",
+	StrengthFormAugmentedGel:                               "Augmented Gel",
+	StrengthFormAugmentedLotion:                            "Augmented Lotion",
+	StrengthFormAugmentedOintment:                          "Augmented Ointment",
+	StrengthFormAutoInjector:                               "Auto-Injector",
+	StrengthFormBandage:                                    "Bandage",
+	StrengthFormBar:                                        "Bar",
+	StrengthFormBarSoap:                                    "Bar Soap",
+	StrengthFormBead:                                       "Bead",
+	StrengthFormBitingCapsule:                              "Biting Capsule",
+	StrengthFormBlock:                                      "Block",
+	StrengthFormBuccalCapsule:                              "Buccal Capsule",
+	StrengthFormBuccalFilm:                                 "Buccal Film",
+	StrengthFormBuccalTablet:                               "Buccal Tablet",
+	StrengthFormCachet:                                     "Cachet",
+	StrengthFormCake:                                       "Cake",
+	StrengthFormCandy:                                      "Candy",
+	StrengthFormCapsule:                                    "Capsule",
+	StrengthFormCapsule12HourSustainedRelease:              "Capsule 12 Hour Sustained Release",
+	StrengthFormCapsule24HourSustainedRelease:              "Capsule 24 Hour Sustained Release",
+	StrengthFormCement:                                     "Cement",
+	StrengthFormChewableBar:                                "Chewable Bar",
+	StrengthFormChewableCapsule:                            "Chewable Capsule",
+	StrengthFormChewableTablet:                             "Chewable Tablet",
+	StrengthFormChewingGum:                                 "Chewing Gum",
+	StrengthFormCigarette:                                  "Cigarette",
+	StrengthFormCloth:                                      "Cloth",
+	StrengthFormCoatedCapsule:                              "Coated Capsule",
+	StrengthFormCoatedPelletInCapsule:                      "Coated Pellet in Capsule",
+	StrengthFormCoatedTablet:                               "Coated Tablet",
+	StrengthFormCompressedSugarCoatedCaplet:                "Compressed Sugar Coated Caplet",
+	StrengthFormConcentrated:                               "Concentrated",
+	StrengthFormConcentratedInjectableSolution:             "Concentrated Injectable Solution",
+	StrengthFormConcentratedOral:                           "Concentrated Oral",
+	StrengthFormConcentratedSolution:                       "Concentrated Solution",
+	StrengthFormCone:                                       "Cone",
+	StrengthFormControlledRelease:                          "Controlled Release",
+	StrengthFormControlledReleaseCapsule:                   "Controlled Release Capsule",
+	StrengthFormControlledReleaseLiquid:                    "Controlled Release Liquid",
+	StrengthFormControlledReleaseTablet:                    "Controlled Release Tablet",
+	StrengthFormCream:                                      "Cream",
+	StrengthFormCrystal:                                    "Crystal",
+	StrengthFormCube:                                       "Cube",
+	StrengthFormCulture:                                    "Culture",
+	StrengthFormDegradableControlledReleaseCapsule:         "Degradable Controlled Release Capsule",
+	StrengthFormDelayedRelease:                             "Delayed Release",
+	StrengthFormDelayedReleaseCapsule:                      "Delayed Release Capsule",
+	StrengthFormDelayedReleaseGranule:                      "Delayed Release Granule",
+	StrengthFormDelayedReleaseParticleTablet:               "Delayed Release Particle Tablet",
+	StrengthFormDelayedReleasePelletInCapsule:              "Delayed Release Pellet in Capsule",
+	StrengthFormDelayedReleaseTablet:                       "Delayed Release Tablet",
+	StrengthFormDental:                                     "Dental",
+	StrengthFormDentalCone:                                 "Dental Cone",
+	StrengthFormDentalLiner:                                "Dental Liner",
+	StrengthFormDentifriceGel:                              "Dentifrice Gel",
+	StrengthFormDentifricePaste:                            "Dentifrice Paste",
+	StrengthFormDentifricePowder:                           "Dentifrice Powder",
+	StrengthFormDiffusionControlledExtendedRelease:         "Diffusion Controlled Extended Release",
+	StrengthFormDisc:                                       "Disc",
+	StrengthFormDisintegratingTablet:                       "Disintegrating Tablet",
+	StrengthFormDispensingTablet:                           "Dispensing Tablet",
+	StrengthFormDissolutionControlledExtendedRelease:       "Dissolution Controlled Extended Release",
+	StrengthFormDouche:                                     "Douche",
+	StrengthFormDouchePowder:                               "Douche Powder",
+	StrengthFormDoucheSolution:                             "Douche Solution",
+	StrengthFormDressing:                                   "Dressing",
+	StrengthFormDrop:                                       "Drop",
+	StrengthFormDropSolution:                               "Drop Solution",
+	StrengthFormDropSuspension:                             "Drop Suspension",
+	StrengthFormDropSuspensionFinal:                        "Drop Suspension Final",
+	StrengthFormDustingPowder:                              "Dusting Powder",
+	StrengthFormEffervescentGranule:                        "Effervescent Granule",
+	StrengthFormEffervescentPowder:                         "Effervescent Powder",
+	StrengthFormEffervescentTablet:                         "Effervescent Tablet",
+	StrengthFormElectricallyControlledExtendedReleasePatch: "Electrically Controlled Extended Release Patch",
+	StrengthFormElixir:                                     "Elixir",
+	StrengthFormEmulsion:                                   "Emulsion",
+	StrengthFormEmulsionForInjection:                       "Emulsion for Injection",
+	StrengthFormEnema:                                      "Enema",
+	StrengthFormEnemaPowder:                                "Enema Powder",
+	StrengthFormEnemaTablet:                                "Enema Tablet",
+	StrengthFormEntericCoatedCapsule:                       "Enteric Coated Capsule",
+	StrengthFormEntericCoatedTablet:                        "Enteric Coated Tablet",
+	StrengthFormErosionControlledExtendedRelease:           "Erosion Controlled Extended Release",
+	StrengthFormExtendedRelease:                            "Extended Release",
+	StrengthFormExtendedReleaseBeadImplant:                 "Extended Release Bead Implant",
+	StrengthFormExtendedReleaseCapsule:                     "Extended Release Capsule",
+	StrengthFormExtendedReleaseCoatedCapsule:               "Extended Release Coated Capsule",
+	StrengthFormExtendedReleaseCoatedPellet:                "Extended Release Coated Pellet",
+	StrengthFormExtendedReleaseCore:                        "Extended Release Core",
+	StrengthFormExtendedReleaseEntericCoatedCapsule:        "Extended Release Enteric Coated Capsule",
+	StrengthFormExtendedReleaseEntericCoatedTablet:         "Extended Release Enteric Coated Tablet",
+	StrengthFormExtendedReleaseFiber:                       "Extended Release Fiber",
+	StrengthFormExtendedReleaseFilm:                        "Extended Release Film",
+	StrengthFormExtendedReleaseFilmCoatedCapsule:           "Extended Release Film Coated Capsule",
+	StrengthFormExtendedReleaseForSuspension:               "Extended Release for Suspension",
+	StrengthFormExtendedReleaseGelFormingSolution:          "Extended Release Gel Forming Solution",
+	StrengthFormExtendedReleaseGranule:                     "Extended Release Granule",
+	StrengthFormExtendedReleaseGranuleForSuspension:        "Extended Release Granule for Suspension",
+	StrengthFormExtendedReleaseInsert:                      "Extended Release Insert",
+	StrengthFormExtendedReleaseLiquid:                      "Extended Release Liquid",
+	StrengthFormExtendedReleasePatch:                       "Extended Release Patch",
+	StrengthFormExtendedReleaseSuppository:                 "Extended Release Suppository",
+	StrengthFormExtendedReleaseSuspension:                  "Extended Release Suspension",
+	StrengthFormExtendedReleaseTablet:                      "Extended Release Tablet",
+	StrengthFormExtract:                                    "Extract",
+	StrengthFormFilm:                                       "Film",
+	StrengthFormFilmCoatedExtendedReleaseTablet:            "Film Coated Extended Release Tablet",
+	StrengthFormFilmCoatedTablet:                           "Film Coated Tablet",
+	StrengthFormFlake:                                      "Flake",
+	StrengthFormFluidExtract:                               "Fluid Extract",
+	StrengthFormFoam:                                       "Foam",
+	StrengthFormFoamBath:                                   "Foam Bath",
+	StrengthFormForSolution:                                "for Solution",
+	StrengthFormForSuspension:                              "for Suspension",
+	StrengthFormFrozenPremixIntravenousPiggybackSolution:   "Frozen Premix Intravenous Piggyback Solution",
+	StrengthFormGargle:                                     "Gargle",
+	StrengthFormGas:                                        "Gas",
+	StrengthFormGel:                                        "Gel",
+	StrengthFormGelFormingDropSolution:                     "Gel Forming Drop Solution",
+	StrengthFormGelFormingSolution:                         "Gel Forming Solution",
+	StrengthFormGelatinCoatedCapsule:                       "Gelatin Coated Capsule",
+	StrengthFormGelatinCoatedTablet:                        "Gelatin Coated Tablet",
+	StrengthFormGenerator:                                  "Generator",
+	StrengthFormGlobule:                                    "Globule",
+	StrengthFormGraft:                                      "Graft",
+	StrengthFormGranule:                                    "Granule",
+	StrengthFormGranuleForReconstitution:                   "Granule for Reconstitution",
+	StrengthFormGranuleForSolution:                         "Granule for Solution",
+	StrengthFormGranuleForSuspension:                       "Granule for Suspension",
+	StrengthFormGum:                                        "Gum",
+	StrengthFormHardCapsule:                                "Hard Capsule",
+	StrengthFormHomeopathicGlobule:                         "Homeopathic Globule",
+	StrengthFormHypodermicTablet:                           "Hypodermic Tablet",
+	StrengthFormImmediateRelease:                           "Immediate Release",
+	StrengthFormImplant:                                    "Implant",
+	StrengthFormImplantablePellet:                          "Implantable Pellet",
+	StrengthFormInhalant:                                   "Inhalant",
+	StrengthFormInhalantPowder:                             "Inhalant Powder",
+	StrengthFormInhalantSolution:                           "Inhalant Solution",
+	StrengthFormInhalerCapsule:                             "Inhaler Capsule",
+	StrengthFormInjectable:                                 "Injectable",
+	StrengthFormInjectableExtendedReleaseSuspension:        "Injectable Extended Release Suspension",
+	StrengthFormInjectableLipidComplex:                     "Injectable Lipid Complex",
+	StrengthFormInjectableLiposomalSuspension:              "Injectable Liposomal Suspension",
+	StrengthFormInjectableLyophilizedPowder:                "Injectable Lyophilized Powder",
+	StrengthFormInjectableSolution:                         "Injectable Solution",
+	StrengthFormInjectableSonicatedSuspension:              "Injectable Sonicated Suspension",
+	StrengthFormInjectableSuspension:                       "Injectable Suspension",
+	StrengthFormInsert:                                     "Insert",
+	StrengthFormInternalPowder:                             "Internal Powder",
+	StrengthFormIntraperitonealSolution:                    "Intraperitoneal Solution",
+	StrengthFormIntrauterineDevice:                         "Intrauterine Device",
+	StrengthFormIntravenousPiggybackSolution:               "Intravenous Piggyback Solution",
+	StrengthFormIntravenousSolution:                        "Intravenous Solution",
+	StrengthFormIrrigant:                                   "Irrigant",
+	StrengthFormJelly:                                      "Jelly",
+	StrengthFormKit:                                        "Kit",
+	StrengthFormLiniment:                                   "Liniment",
+	StrengthFormLiposomalInjection:                         "Liposomal Injection",
+	StrengthFormLipstick:                                   "Lipstick",
+	StrengthFormLiquid:                                     "Liquid",
+	StrengthFormLiquidFilledCapsule:                        "Liquid Filled Capsule",
+	StrengthFormLiquidSoap:                                 "Liquid Soap",
+	StrengthFormLollipop:                                   "Lollipop",
+	StrengthFormLotion:                                     "Lotion",
+	StrengthFormLotionShampoo:                              "Lotion Shampoo",
+	StrengthFormLozenge:                                    "Lozenge",
+	StrengthFormLyophilizedPowderForExtendedReleaseInjectableSuspension: "Lyophilized Powder for Extended Release Injectable Suspension",
+	StrengthFormLyophilizedPowderForInjectableLiposomalSuspension:       "Lyophilized Powder for Injectable Liposomal Suspension",
+	StrengthFormLyophilizedPowderForInjectableSolution:                  "Lyophilized Powder for Injectable Solution",
+	StrengthFormLyophilizedPowderForInjectableSuspension:                "Lyophilized Powder for Injectable Suspension",
+	StrengthFormMedicatedAdhesivePatch:                                  "Medicated Adhesive Patch",
+	StrengthFormMedicatedBarSoap:                                        "Medicated Bar Soap",
+	StrengthFormMedicatedFilm:                                           "Medicated Film",
+	StrengthFormMedicatedLiquidSoap:                                     "Medicated Liquid Soap",
+	StrengthFormMedicatedPad:                                            "Medicated Pad",
+	StrengthFormMedicatedShampoo:                                        "Medicated Shampoo",
+	StrengthFormMedicatedSoap:                                           "Medicated Soap",
+	StrengthFormMedicatedSponge:                                         "Medicated Sponge",
+	StrengthFormMedicatedSwab:                                           "Medicated Swab",
+	StrengthFormMedicatedTape:                                           "Medicated Tape",
+	StrengthFormMembraneCoatedCapsule:                                   "Membrane Coated Capsule",
+	StrengthFormMembraneCoatedTablet:                                    "Membrane Coated Tablet",
+	StrengthFormMeteredAerosol:                                          "Metered Aerosol",
+	StrengthFormMeteredDoseInhaler:                                      "Metered Dose Inhaler",
+	StrengthFormMeteredGel:                                              "Metered Gel",
+	StrengthFormMeteredPowder:                                           "Metered Powder",
+	StrengthFormMeteredSpray:                                            "Metered Spray",
+	StrengthFormMicroEnema:                                              "Micro Enema",
+	StrengthFormModifiedRelease:                                         "Modified Release",
+	StrengthFormMouthwash:                                               "Mouthwash",
+	StrengthFormMucosalSpray:                                            "Mucosal Spray",
+	StrengthFormMucousMembraneTopicalSolution:                           "Mucous Membrane Topical Solution",
+	StrengthFormMultilayeredExtendedReleaseTablet:                       "Multilayered Extended Release Tablet",
+	StrengthFormMultilayeredTablet:                                      "Multilayered Tablet",
+	StrengthFormNasal:                                                   "Nasal",
+	StrengthFormNasalCream:                                              "Nasal Cream",
+	StrengthFormNasalGel:                                                "Nasal Gel",
+	StrengthFormNasalInhalant:                                           "Nasal Inhalant",
+	StrengthFormNasalInhaler:                                            "Nasal Inhaler",
+	StrengthFormNasalOintment:                                           "Nasal Ointment",
+	StrengthFormNasalSolution:                                           "Nasal Solution",
+	StrengthFormNasalSpray:                                              "Nasal Spray",
+	StrengthFormNasalSuspension:                                         "Nasal Suspension",
+	StrengthFormNonAerosolSpray:                                         "Non-Aerosol Spray",
+	StrengthFormNonMedicatedSwab:                                        "Non-Medicated Swab",
+	StrengthFormNotApplicable:                                           "Not Applicable",
+	StrengthFormOcularSystem:                                            "Ocular System",
+	StrengthFormOil:                                                     "Oil",
+	StrengthFormOintment:                                                "Ointment",
+	StrengthFormOphthalmic:                                              "Ophthalmic",
+	StrengthFormOphthalmicCream:                                         "Ophthalmic Cream",
+	StrengthFormOphthalmicGel:                                           "Ophthalmic Gel",
+	StrengthFormOphthalmicIrrigationSolution:                            "Ophthalmic Irrigation Solution",
+	StrengthFormOphthalmicLiquid:                                        "Ophthalmic Liquid",
+	StrengthFormOphthalmicOintment:                                      "Ophthalmic Ointment",
+	StrengthFormOphthalmicSolution:                                      "Ophthalmic Solution",
+	StrengthFormOphthalmicSuspension:                                    "Ophthalmic Suspension",
+	StrengthFormOral:                                                    "Oral",
+	StrengthFormOralCapsule:                                             "Oral Capsule",
+	StrengthFormOralCream:                                               "Oral Cream",
+	StrengthFormOralFoam:                                                "Oral Foam",
+	StrengthFormOralGel:                                                 "Oral Gel",
+	StrengthFormOralOintment:                                            "Oral Ointment",
+	StrengthFormOralPaste:                                               "Oral Paste",
+	StrengthFormOralPowder:                                              "Oral Powder",
+	StrengthFormOralReconstitutedSuspension:                             "Oral Reconstituted Suspension",
+	StrengthFormOralSolution:                                            "Oral Solution",
+	StrengthFormOralSpray:                                               "Oral Spray",
+	StrengthFormOralStrip:                                               "Oral Strip",
+	StrengthFormOralSuspension:                                          "Oral Suspension",
+	StrengthFormOralSuspensionFinal:                                     "Oral Suspension Final",
+	StrengthFormOralTablet:                                              "Oral Tablet",
+	StrengthFormOrallyDisintegratingDelayedReleaseTablet:                "Orally Disintegrating Delayed Release Tablet",
+	StrengthFormOrallyDisintegratingTablet:                              "Orally Disintegrating Tablet",
+	StrengthFormOsmoticLaserDrilledTablet:                               "Osmotic Laser Drilled Tablet",
+	StrengthFormOsmoticPumpExtendedRelease:                              "Osmotic Pump Extended Release",
+	StrengthFormOtic:                                                    "Otic",
+	StrengthFormOticCream:                                               "Otic Cream",
+	StrengthFormOticOintment:                                            "Otic Ointment",
+	StrengthFormOticSolution:                                            "Otic Solution",
+	StrengthFormOticSuspension:                                          "Otic Suspension",
+	StrengthFormPack:                                                    "Pack",
+	StrengthFormPacking:                                                 "Packing",
+	StrengthFormPad:                                                     "Pad",
+	StrengthFormParenteral:                                              "Parenteral",
+	StrengthFormPaste:                                                   "Paste",
+	StrengthFormPastille:                                                "Pastille",
+	StrengthFormPatch:                                                   "Patch",
+	StrengthFormPediatricLiquid:                                         "Pediatric Liquid",
+	StrengthFormPediatricSuppository:                                    "Pediatric Suppository",
+	StrengthFormPellet:                                                  "Pellet",
+	StrengthFormPharmaceutical:                                          "Pharmaceutical",
+	StrengthFormPill:                                                    "Pill",
+	StrengthFormPlaster:                                                 "Plaster",
+	StrengthFormPolymericMicrosphere:                                    "Polymeric Microsphere",
+	StrengthFormPoultice:                                                "Poultice",
+	StrengthFormPowder:                                                  "Powder",
+	StrengthFormPowderAerosol:                                           "Powder Aerosol",
+	StrengthFormPowderForInjectableExtendedReleaseSuspension:            "Powder for Injectable Extended Release Suspension",
+	StrengthFormPowderForInjectableSolution:                             "Powder for Injectable Solution",
+	StrengthFormPowderForInjectableSuspension:                           "Powder for Injectable Suspension",
+	StrengthFormPowderForInjection:                                      "Powder for Injection",
+	StrengthFormPowderForOralSolution:                                   "Powder for Oral Solution",
+	StrengthFormPowderForOralSuspension:                                 "Powder for Oral Suspension",
+	StrengthFormPowderForReconstitution:                                 "Powder for Reconstitution",
+	StrengthFormPowderForSolution:                                       "Powder for Solution",
+	StrengthFormPowderForSuspension:                                     "Powder for Suspension",
+	StrengthFormPowderInhaler:                                           "Powder Inhaler",
+	StrengthFormPowderLikeNonEffervescentGranule:                        "Powder Like Non-Effervescent Granule",
+	StrengthFormPrefilledApplicator:                                     "Prefilled Applicator",
+	StrengthFormPreFilledPenSyringe:                                     "Pre-filled Pen Syringe",
+	StrengthFormPrefilledSyringe:                                        "Prefilled Syringe",
+	StrengthFormPudding:                                                 "Pudding",
+	StrengthFormReconstitutedOralDrop:                                   "Reconstituted Oral Drop",
+	StrengthFormReconstitutedOralSolution:                               "Reconstituted Oral Solution",
+	StrengthFormRectal:                                                  "Rectal",
+	StrengthFormRectalCream:                                             "Rectal Cream",
+	StrengthFormRectalFoam:                                              "Rectal Foam",
+	StrengthFormRectalGel:                                               "Rectal Gel",
+	StrengthFormRectalOintment:                                          "Rectal Ointment",
+	StrengthFormRectalPowder:                                            "Rectal Powder",
+	StrengthFormRectalSpray:                                             "Rectal Spray",
+	StrengthFormRectalSuppository:                                       "Rectal Suppository",
+	StrengthFormResinGum:                                                "Resin Gum",
+	StrengthFormRing:                                                    "Ring",
+	StrengthFormRinse:                                                   "Rinse",
+	StrengthFormSalve:                                                   "Salve",
+	StrengthFormSemisolid:                                               "Semisolid",
+	StrengthFormShampoo:                                                 "Shampoo",
+	StrengthFormShampooSuspension:                                       "Shampoo Suspension",
+	StrengthFormSkinPatch:                                               "Skin Patch",
+	StrengthFormSoap:                                                    "Soap",
+	StrengthFormSoftCapsule:                                             "Soft Capsule",
+	StrengthFormSolid:                                                   "Solid",
+	StrengthFormSolubleFilm:                                             "Soluble Film",
+	StrengthFormSolubleTablet:                                           "Soluble Tablet",
+	StrengthFormSolution:                                                "Solution",
+	StrengthFormSolutionForReconstitution:                               "Solution for Reconstitution",
+	StrengthFormSolutionForSlush:                                        "Solution for Slush",
+	StrengthFormSponge:                                                  "Sponge",
+	StrengthFormSpray:                                                   "Spray",
+	StrengthFormSpraySuspension:                                         "Spray Suspension",
+	StrengthFormSprinkleCapsule:                                         "Sprinkle Capsule",
+	StrengthFormStick:                                                   "Stick",
+	StrengthFormStrip:                                                   "Strip",
+	StrengthFormSublingualTablet:                                        "Sublingual Tablet",
+	StrengthFormSugarCoatedTablet:                                       "Sugar Coated Tablet",
+	StrengthFormSuppository:                                             "Suppository",
+	StrengthFormSuspension:                                              "Suspension",
+	StrengthFormSuspension12HourSustainedRelease:                        "Suspension 12 Hour Sustained Release",
+	StrengthFormSuspensionForReconstitution:                             "Suspension for Reconstitution",
+	StrengthFormSustainedRelease:                                        "Sustained Release",
+	StrengthFormSustainedReleaseBuccalTablet:                            "Sustained Release Buccal Tablet",
+	StrengthFormSustainedReleaseCapsule:                                 "Sustained Release Capsule",
+	StrengthFormSustainedReleaseOralLiquid:                              "Sustained Release Oral Liquid",
+	StrengthFormSustainedReleaseParenteral:                              "Sustained Release Parenteral",
+	StrengthFormSustainedReleasePelletCapsule:                           "Sustained Release Pellet Capsule",
+	StrengthFormSustainedReleaseTablet:                                  "Sustained Release Tablet",
+	StrengthFormSuture:                                                  "Suture",
+	StrengthFormSwab:                                                    "Swab",
+	StrengthFormSyrup:                                                   "Syrup",
+	StrengthFormTablet:                                                  "Tablet",
+	StrengthFormTablet12HourSustainedRelease:                            "Tablet 12 Hour Sustained Release",
+	StrengthFormTablet24HourSustainedRelease:                            "Tablet 24 Hour Sustained Release",
+	StrengthFormTabletCoatedParticle:                                    "Tablet Coated Particle",
+	StrengthFormTabletForSolution:                                       "Tablet for Solution",
+	StrengthFormTabletForSuspension:                                     "Tablet for Suspension",
+	StrengthFormTabletParticleCrystal:                                   "Tablet Particle Crystal",
+	StrengthFormTabletTriturate:                                         "Tablet Triturate",
+	StrengthFormTampon:                                                  "Tampon",
+	StrengthFormTape:                                                    "Tape",
+	StrengthFormTar:                                                     "Tar",
+	StrengthFormThroatSpray:                                             "Throat Spray",
+	StrengthFormTincture:                                                "Tincture",
+	StrengthFormToothpaste:                                              "Toothpaste",
+	StrengthFormTopical:                                                 "Topical",
+	StrengthFormTopicalCream:                                            "Topical Cream",
+	StrengthFormTopicalFoam:                                             "Topical Foam",
+	StrengthFormTopicalGel:                                              "Topical Gel",
+	StrengthFormTopicalLotion:                                           "Topical Lotion",
+	StrengthFormTopicalOil:                                              "Topical Oil",
+	StrengthFormTopicalOintment:                                         "Topical Ointment",
+	StrengthFormTopicalPowder:                                           "Topical Powder",
+	StrengthFormTopicalPowderSpray:                                      "Topical Powder Spray",
+	StrengthFormTopicalSolution:                                         "Topical Solution",
+	StrengthFormTopicalSpray:                                            "Topical Spray",
+	StrengthFormTopicalSuspension:                                       "Topical Suspension",
+	StrengthFormTroche:                                                  "Troche",
+	StrengthFormUnassigned:                                              "Unassigned",
+	StrengthFormUnmedicatedSponge:                                       "Unmedicated Sponge",
+	StrengthFormUnspecified:                                             "Unspecified",
+	StrengthFormUrethral:                                                "Urethral",
+	StrengthFormUrethralGel:                                             "Urethral Gel",
+	StrengthFormUrethralSuppository:                                     "Urethral Suppository",
+	StrengthFormVaginal:                                                 "Vaginal",
+	StrengthFormVaginalCream:                                            "Vaginal Cream",
+	StrengthFormVaginalDiaphragm:                                        "Vaginal Diaphragm",
+	StrengthFormVaginalFoam:                                             "Vaginal Foam",
+	StrengthFormVaginalGel:                                              "Vaginal Gel",
+	StrengthFormVaginalOintment:                                         "Vaginal Ointment",
+	StrengthFormVaginalPowder:                                           "Vaginal Powder",
+	StrengthFormVaginalRing:                                             "Vaginal Ring",
+	StrengthFormVaginalSpray:                                            "Vaginal Spray",
+	StrengthFormVaginalSuppository:                                      "Vaginal Suppository",
+	StrengthFormVaginalTablet:                                           "Vaginal Tablet",
+	StrengthFormWafer:                                                   "Wafer",
+	StrengthFormWash:                                                    "Wash",
+	StrengthFormWax:                                                     "Wax",
+	StrengthFormWoundStick:                                              "Wound Stick",
+}
+
+// String returns the NCPDP preferred term, or the code itself when it is
+// not in the subset.
+func (c StrengthFormCode) String() string {
+	if term, ok := strengthFormTerms[c]; ok {
+		return term
+	}
+
+	return string(c)
+}
+
+func (c StrengthFormCode) Valid() bool {
+	_, ok := strengthFormTerms[c]
+	return ok
+}
+
+func (c StrengthFormCode) Subset() string {
+	return SubsetStrengthForm
+}
+
+// StrengthUnitCode is an NCIt code from the StrengthUnitOfMeasure terminology subset.
+type StrengthUnitCode string
+
+const (
+	StrengthUnitAttocurie                      StrengthUnitCode = "C70518"
+	StrengthUnitBecquerel                      StrengthUnitCode = "C42562"
+	StrengthUnitCenticurie                     StrengthUnitCode = "C70515"
+	StrengthUnitCurie                          StrengthUnitCode = "C48466"
+	StrengthUnitDay                            StrengthUnitCode = "C25301"
+	StrengthUnitDecicurie                      StrengthUnitCode = "C70514"
+	StrengthUnitFemtocurie                     StrengthUnitCode = "C70517"
+	StrengthUnitGigabecquerel                  StrengthUnitCode = "C70513"
+	StrengthUnitGram                           StrengthUnitCode = "C48155"
+	StrengthUnitInternationalUnit              StrengthUnitCode = "C48579"
+	StrengthUnitKilobecquerel                  StrengthUnitCode = "C70511"
+	StrengthUnitKilogram                       StrengthUnitCode = "C28252"
+	StrengthUnitLiter                          StrengthUnitCode = "C48505"
+	StrengthUnitMegabecquerel                  StrengthUnitCode = "C70512"
+	StrengthUnitMgMl                           StrengthUnitCode = "C42576"
+	StrengthUnitMicrocurie                     StrengthUnitCode = "C48507"
+	StrengthUnitMicrogram                      StrengthUnitCode = "C48152"
+	StrengthUnitMicrogramPerDay                StrengthUnitCode = "C71205"
+	StrengthUnitMicrogramPerFifteenMilliliters StrengthUnitCode = "C91132"
+	StrengthUnitMicrogramPerHour               StrengthUnitCode = "C67394"
+	StrengthUnitMicrogramPerMilliliter         StrengthUnitCode = "C64572"
+	StrengthUnitMicrogramPerThreeDays          StrengthUnitCode = "C91135"
+	StrengthUnitMillicurie                     StrengthUnitCode = "C48511"
+	StrengthUnitMilliequivalent                StrengthUnitCode = "C48512"
+	StrengthUnitMilligram                      StrengthUnitCode = "C28253"
+	StrengthUnitMilligramPerFiveMilliliters    StrengthUnitCode = "C91131"
+	StrengthUnitMilliliter                     StrengthUnitCode = "C28254"
+	StrengthUnitMillimole                      StrengthUnitCode = "C48513"
+	StrengthUnitMillionUnits                   StrengthUnitCode = "C67310"
+	StrengthUnitNanocurie                      StrengthUnitCode = "C67352"
+	StrengthUnitPercentage                     StrengthUnitCode = "C25613"
+	StrengthUnitPicocurie                      StrengthUnitCode = "C70516"
+	StrengthUnitUnit                           StrengthUnitCode = "C44278"
+	StrengthUnitUnspecified                    StrengthUnitCode = "C38046"
+	StrengthUnitYoctocurie                     StrengthUnitCode = "C70520"
+	StrengthUnitZeptocurie                     StrengthUnitCode = "C70519"
+)
+
+var strengthUnitTerms = map[StrengthUnitCode]string{
+	StrengthUnitAttocurie:                      "Attocurie",
+	StrengthUnitBecquerel:                      "Becquerel",
+	StrengthUnitCenticurie:                     "Centicurie",
+	StrengthUnitCurie:                          "Curie",
+	StrengthUnitDay:                            "Day",
+	StrengthUnitDecicurie:                      "Decicurie",
+	StrengthUnitFemtocurie:                     "Femtocurie",
+	StrengthUnitGigabecquerel:                  "Gigabecquerel",
+	StrengthUnitGram:                           "Gram",
+	StrengthUnitInternationalUnit:              "International Unit",
+	StrengthUnitKilobecquerel:                  "Kilobecquerel",
+	StrengthUnitKilogram:                       "Kilogram",
+	StrengthUnitLiter:                          "Liter",
+	StrengthUnitMegabecquerel:                  "Megabecquerel",
+	StrengthUnitMgMl:                           "mg/ml",
+	StrengthUnitMicrocurie:                     "Microcurie",
+	StrengthUnitMicrogram:                      "Microgram",
+	StrengthUnitMicrogramPerDay:                "Microgram per Day",
+	StrengthUnitMicrogramPerFifteenMilliliters: "Microgram per Fifteen Milliliters",
+	StrengthUnitMicrogramPerHour:               "Microgram per Hour",
+	StrengthUnitMicrogramPerMilliliter:         "Microgram per Milliliter",
+	StrengthUnitMicrogramPerThreeDays:          "Microgram per Three Days",
+	StrengthUnitMillicurie:                     "Millicurie",
+	StrengthUnitMilliequivalent:                "Milliequivalent",
+	StrengthUnitMilligram:                      "Milligram",
+	StrengthUnitMilligramPerFiveMilliliters:    "Milligram per Five Milliliters",
+	StrengthUnitMilliliter:                     "Milliliter",
+	StrengthUnitMillimole:                      "Millimole",
+	StrengthUnitMillionUnits:                   "Million Units",
+	StrengthUnitNanocurie:                      "Nanocurie",
+	StrengthUnitPercentage:                     "Percentage",
+	StrengthUnitPicocurie:                      "Picocurie",
+	StrengthUnitUnit:                           "Unit",
+	StrengthUnitUnspecified:                    "Unspecified",
+	StrengthUnitYoctocurie:                     "Yoctocurie",
+	StrengthUnitZeptocurie:                     "Zeptocurie",
+}
+
+// String returns the NCPDP preferred term, or the code itself when it is
+// not in the subset.
+func (c StrengthUnitCode) String() string {
+	if term, ok := strengthUnitTerms[c]; ok {
+		return term
+	}
+
+	return string(c)
+}
+
+func (c StrengthUnitCode) Valid() bool {
+	_, ok := strengthUnitTerms[c]
+	return ok
+}
+
+func (c StrengthUnitCode) Subset() string {
+	return SubsetStrengthUnitOfMeasure
+}
@@ -0,0 +1,466 @@
+package ncpdp
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTerminologyIndex(t *testing.T) {
+	idx, err := LoadTerminologyIndex(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := idx.Len(); got != 543 {
+		t.Errorf("Len() = %v, want 543", got)
+	}
+
+	tests := []struct {
+		name      string
+		subset    string
+		code      string
+		term      string
+		wantTerm  string
+		wantCount int
+	}{
+		{
+			name:      "DEA schedule",
+			subset:    "C89507",
+			code:      "C48672",
+			wantTerm:  "Schedule I Substance",
+			wantCount: 1,
+		},
+		{
+			name:      "code in several subsets",
+			subset:    "C121847",
+			code:      "C48512",
+			wantTerm:  "Milliequivalent",
+			wantCount: 2,
+		},
+		{
+			name:      "unknown code",
+			subset:    "C89510",
+			code:      "C486232",
+			wantCount: 0,
+		},
+		{
+			name:      "code from another subset",
+			subset:    "C89510",
+			code:      "C48672",
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(idx.FindByCode(tt.code)); got != tt.wantCount {
+				t.Errorf("FindByCode() returned %d terms, want %d", got, tt.wantCount)
+			}
+
+			got := idx.Find(tt.subset, tt.code)
+			if tt.wantTerm == "" {
+				if got != nil {
+					t.Errorf("Find() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.PreferredTerm != tt.wantTerm {
+				t.Errorf("Find() = %+v, want %v", got, tt.wantTerm)
+			}
+		})
+	}
+
+	for _, term := range []string{"meq", "Milliequivalent", " MILLIEQUIVALENT "} {
+		got := idx.FindByTerm(term)
+		if len(got) != 2 || got[0].NCItCode != "C48512" {
+			t.Errorf("FindByTerm(%q) = %v, want both C48512 entries", term, got)
+		}
+	}
+}
+
+func TestTerminologyIndexMatchesLinearScan(t *testing.T) {
+	terms, err := LoadTerminology(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := NewTerminologyIndex(terms)
+
+	for _, term := range *terms {
+		want := terms.FindTermByQuantityUnitOfMeasureCode(term.NCItCode)
+		if got := idx.FindTermByQuantityUnitOfMeasureCode(term.NCItCode); got != want && term.NCItSubsetCode != "NCIt Subset Code" {
+			t.Errorf("FindTermByQuantityUnitOfMeasureCode(%v) = %v, want %v", term.NCItCode, got, want)
+		}
+	}
+
+	if got := NewTerminologyIndex(nil).Find("C89510", "C48542"); got != nil {
+		t.Errorf("empty index Find() = %v, want nil", got)
+	}
+
+	header := Terminologies{
+		{NCItSubsetCode: "Subset", NCItCode: "Code"},
+		{NCItSubsetCode: "C89510", NCItCode: "C48542", PreferredTerm: "Tablet"},
+	}
+	if got := NewTerminologyIndex(&header).Len(); got != 1 {
+		t.Errorf("Len() with a renamed header row = %v, want 1", got)
+	}
+}
+
+func TestTerminologyIndexConcurrentReads(t *testing.T) {
+	idx, err := LoadTerminologyIndex(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if idx.Find("C89510", "C48542") == nil || len(idx.FindByTerm("tablet")) == 0 {
+					t.Error("concurrent lookup failed")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFindInSubset(t *testing.T) {
+	terms, err := LoadTerminology(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := NewTerminologyIndex(terms)
+
+	type finder interface {
+		FindInSubset(subsetCode, code string) *Terminology
+		FindDEASchedule(code string) *Terminology
+		FindQuantityUnitOfMeasure(code string) *Terminology
+		FindStrengthForm(code string) *Terminology
+		FindMeasurementUnitCode(code string) *Terminology
+	}
+
+	tests := []struct {
+		name string
+		find func(f finder) *Terminology
+		want string
+	}{
+		{
+			name: "quantity unit",
+			find: func(f finder) *Terminology { return f.FindQuantityUnitOfMeasure("C48480") },
+			want: "Capsule",
+		},
+		{
+			name: "DEA schedule is not a quantity unit",
+			find: func(f finder) *Terminology { return f.FindQuantityUnitOfMeasure("C48672") },
+		},
+		{
+			name: "strength form is not a quantity unit",
+			find: func(f finder) *Terminology { return f.FindQuantityUnitOfMeasure("C78746") },
+		},
+		{
+			name: "strength form",
+			find: func(f finder) *Terminology { return f.FindStrengthForm("C78746") },
+			want: "21 Day Tablet",
+		},
+		{
+			name: "DEA schedule",
+			find: func(f finder) *Terminology { return f.FindDEASchedule("C48672") },
+			want: "Schedule I Substance",
+		},
+		{
+			name: "measurement unit",
+			find: func(f finder) *Terminology { return f.FindMeasurementUnitCode("C49673") },
+			want: "Beats per Minute",
+		},
+		{
+			name: "explicit subset",
+			find: func(f finder) *Terminology { return f.FindInSubset(SubsetDoseUnitOfMeasure, "C48512") },
+			want: "Milliequivalent",
+		},
+	}
+
+	for _, tt := range tests {
+		for name, f := range map[string]finder{"Terminologies": *terms, "TerminologyIndex": idx} {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				got := tt.find(f)
+				if tt.want == "" {
+					if got != nil {
+						t.Errorf("got %+v, want nil", got)
+					}
+					return
+				}
+				if got == nil || got.PreferredTerm != tt.want {
+					t.Errorf("got %+v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestTerminologyIndexReturnsCopies(t *testing.T) {
+	terms, err := LoadTerminology(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := NewTerminologyIndex(terms)
+
+	tests := []struct {
+		name   string
+		lookup func() []*Terminology
+	}{
+		{
+			name:   "FindByCode",
+			lookup: func() []*Terminology { return idx.FindByCode("C48542") },
+		},
+		{
+			name:   "FindByTerm",
+			lookup: func() []*Terminology { return idx.FindByTerm("Tablet") },
+		},
+		{
+			name:   "Subset",
+			lookup: func() []*Terminology { return idx.Subset("QuantityUnitOfMeasure") },
+		},
+		{
+			name:   "Find",
+			lookup: func() []*Terminology { return []*Terminology{idx.Find(SubsetQuantityUnitOfMeasure, "C48542")} },
+		},
+		{
+			name:   "FindQuantityUnitOfMeasure",
+			lookup: func() []*Terminology { return []*Terminology{idx.FindQuantityUnitOfMeasure("C48542")} },
+		},
+		{
+			name: "FindByText",
+			lookup: func() []*Terminology {
+				var found []*Terminology
+				for _, m := range idx.FindByText(SubsetQuantityUnitOfMeasure, "tablet") {
+					found = append(found, m.Term)
+				}
+				return found
+			},
+		},
+		{
+			name: "Terminologies.FindInSubset",
+			lookup: func() []*Terminology {
+				return []*Terminology{terms.FindInSubset(SubsetQuantityUnitOfMeasure, "C48542")}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.lookup()
+			if len(got) == 0 {
+				t.Fatalf("%s() returned no terms", tt.name)
+			}
+			want, code := len(got), got[0].NCItCode
+
+			got[0].NCItCode = "changed"
+			got[0] = nil
+
+			again := tt.lookup()
+			if len(again) != want || again[0] == nil || again[0].NCItCode != code {
+				t.Errorf("%s() result changed after modifying a previous result: %v", tt.name, again)
+			}
+		})
+	}
+}
+
+func TestSubset(t *testing.T) {
+	terms, err := LoadTerminology(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := NewTerminologyIndex(terms)
+
+	tests := []struct {
+		name string
+		want int
+	}{
+		{name: "DEASchedule", want: 6},
+		{name: "NCPDP QuantityUnitOfMeasure Terminology", want: 28},
+		{name: SubsetStrengthForm, want: 380},
+		{name: "StrengthUnitOfMeasure", want: 36},
+		{name: "DoseUnitOfMeasure", want: 63},
+		{name: "MeasurementUnitCode", want: 30},
+		{name: "Unknown", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(terms.Subset(tt.name)); got != tt.want {
+				t.Errorf("Terminologies.Subset() returned %d terms, want %d", got, tt.want)
+			}
+			if got := len(idx.Subset(tt.name)); got != tt.want {
+				t.Errorf("TerminologyIndex.Subset() returned %d terms, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindByText(t *testing.T) {
+	terms, err := LoadTerminology(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := NewTerminologyIndex(terms)
+
+	tests := []struct {
+		name        string
+		subset      string
+		text        string
+		wantCode    string
+		wantQuality MatchQuality
+		wantCount   int
+	}{
+		{
+			name:        "preferred term",
+			subset:      SubsetQuantityUnitOfMeasure,
+			text:        "Tablet",
+			wantCode:    "C48542",
+			wantQuality: MatchPreferredTerm,
+			wantCount:   1,
+		},
+		{
+			name:        "plural abbreviation",
+			subset:      SubsetQuantityUnitOfMeasure,
+			text:        "tabs",
+			wantCode:    "C48542",
+			wantQuality: MatchNormalized,
+			wantCount:   1,
+		},
+		{
+			name:        "abbreviation",
+			subset:      SubsetQuantityUnitOfMeasure,
+			text:        "mL",
+			wantCode:    "C28254",
+			wantQuality: MatchNormalized,
+			wantCount:   1,
+		},
+		{
+			name:        "synonym",
+			subset:      SubsetDoseUnitOfMeasure,
+			text:        "MEQ",
+			wantCode:    "C48512",
+			wantQuality: MatchSynonym,
+			wantCount:   1,
+		},
+		{
+			name:        "NCIt preferred term",
+			subset:      SubsetQuantityUnitOfMeasure,
+			text:        "capsule dosing unit",
+			wantCode:    "C48480",
+			wantQuality: MatchNCItPreferredTerm,
+			wantCount:   1,
+		},
+		{
+			name:        "plural",
+			subset:      SubsetQuantityUnitOfMeasure,
+			text:        "Patches",
+			wantCode:    "C48524",
+			wantQuality: MatchNormalized,
+			wantCount:   1,
+		},
+		{
+			name:        "word ranked after exact match",
+			subset:      SubsetDoseUnitOfMeasure,
+			text:        "strip",
+			wantCode:    "C48538",
+			wantQuality: MatchPreferredTerm,
+			wantCount:   2,
+		},
+		{
+			name:        "word match",
+			subset:      SubsetQuantityUnitOfMeasure,
+			text:        "needles",
+			wantCode:    "C120216",
+			wantQuality: MatchWord,
+			wantCount:   1,
+		},
+		{
+			name:      "other subset",
+			subset:    SubsetDEASchedule,
+			text:      "Tablet",
+			wantCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		for name, find := range map[string]func(subset, text string) []TermMatch{
+			"Terminologies":    terms.FindByText,
+			"TerminologyIndex": idx.FindByText,
+		} {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				got := find(tt.subset, tt.text)
+				if len(got) != tt.wantCount {
+					t.Fatalf("FindByText() = %d matches, want %d", len(got), tt.wantCount)
+				}
+				if tt.wantCount == 0 {
+					return
+				}
+				if got[0].Term.NCItCode != tt.wantCode || got[0].Quality != tt.wantQuality {
+					t.Errorf("FindByText() best = %v (%v), want %v (%v)", got[0].Term.NCItCode, got[0].Quality, tt.wantCode, tt.wantQuality)
+				}
+			})
+		}
+	}
+
+	got := idx.FindByText(SubsetDoseUnitOfMeasure, "pen")
+	if len(got) < 1 || got[0].Term.NCItCode != "C122635" {
+		t.Errorf("FindByText(pen) = %+v, want Pre-Filled Pen Syringe", got)
+	}
+}
+
+func TestGeneratedCodes(t *testing.T) {
+	idx, err := LoadTerminologyIndex(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type code interface {
+		String() string
+		Valid() bool
+		Subset() string
+	}
+
+	tests := []struct {
+		name string
+		code code
+		raw  string
+		want string
+	}{
+		{name: "quantity unit", code: QuantityUnitTablet, raw: string(QuantityUnitTablet), want: "Tablet"},
+		{name: "DEA schedule", code: DEAScheduleScheduleIISubstance, raw: string(DEAScheduleScheduleIISubstance), want: "Schedule II Substance"},
+		{name: "dose unit", code: DoseUnitMilliequivalent, raw: string(DoseUnitMilliequivalent), want: "Milliequivalent"},
+		{name: "measurement unit", code: MeasurementUnitKilogram, raw: string(MeasurementUnitKilogram), want: "Kilogram"},
+		{name: "strength form", code: StrengthForm21DayTablet, raw: string(StrengthForm21DayTablet), want: "21 Day Tablet"},
+		{name: "strength unit", code: StrengthUnitMgMl, raw: string(StrengthUnitMgMl), want: "mg/ml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.code.Valid() {
+				t.Error("Valid() = false")
+			}
+			if got := tt.code.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			term := idx.Find(tt.code.Subset(), tt.raw)
+			if term == nil || term.PreferredTerm != tt.want {
+				t.Errorf("Find(%v, %v) = %+v, want %v", tt.code.Subset(), tt.raw, term, tt.want)
+			}
+		})
+	}
+
+	if StrengthFormCode(QuantityUnitTablet).Valid() || QuantityUnitCode(StrengthForm21DayTablet).Valid() {
+		t.Error("Valid() does not respect the subset")
+	}
+	if got := StrengthFormCode(QuantityUnitTablet).String(); got != string(QuantityUnitTablet) {
+		t.Errorf("String() of a code outside the subset = %q, want %q", got, QuantityUnitTablet)
+	}
+	if len(quantityUnitTerms) != len(idx.Subset("QuantityUnitOfMeasure")) {
+		t.Errorf("quantityUnitTerms has %d codes, want %d", len(quantityUnitTerms), len(idx.Subset("QuantityUnitOfMeasure")))
+	}
+}
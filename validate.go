@@ -0,0 +1,697 @@
+package ncpdp
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Validate checks msg against the SCRIPT 2017071 schema rules for required
+// elements, choice groups, field lengths and enumerations. The returned error
+// is a ValidationErrors holding every violation, or nil.
+func Validate(msg *Message) error {
+	if msg == nil {
+		return ValidationErrors{{
+			Path:            "Message",
+			Message:         "message is nil",
+			Code:            ErrorTransactionRejected,
+			DescriptionCode: DescriptionGenericError,
+		}}
+	}
+
+	v := new(validator)
+	walkElements("", reflect.ValueOf(msg), v.check)
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) addValue(path, value, descriptionCode, format string, args ...interface{}) {
+	if path == "" {
+		path = "Message"
+	}
+
+	v.errs = append(v.errs, &ValidationError{
+		Path:            path,
+		Value:           value,
+		Message:         fmt.Sprintf(format, args...),
+		Code:            ErrorTransactionRejected,
+		DescriptionCode: descriptionCode,
+	})
+}
+
+func (v *validator) missing(path string) {
+	v.addValue(path, "", DescriptionRequiredElementMissing, "required element is missing")
+}
+
+func (v *validator) invalid(path, value, format string, args ...interface{}) {
+	v.addValue(path, value, DescriptionInvalidValue, format, args...)
+}
+
+func (v *validator) required(path, value string) {
+	if value == "" {
+		v.missing(path)
+	}
+}
+
+// requiredElement reports an element held by value that is absent, which
+// is when it is zero.
+func (v *validator) requiredElement(path string, element interface{}) {
+	if reflect.ValueOf(element).IsZero() {
+		v.missing(path)
+	}
+}
+
+func (v *validator) maxLen(path, value string, n int) {
+	if len(value) > n {
+		v.addValue(path, value, DescriptionLengthExceeded, "length %d exceeds maximum of %d", len(value), n)
+	}
+}
+
+func (v *validator) exactLen(path, value string, n int) {
+	switch {
+	case len(value) > n:
+		v.addValue(path, value, DescriptionLengthExceeded, "length %d, want %d", len(value), n)
+	case value != "" && len(value) < n:
+		v.invalid(path, value, "length %d, want %d", len(value), n)
+	}
+}
+
+func (v *validator) digits(path, value string) {
+	if _, err := strconv.ParseUint(value, 10, 64); value != "" && err != nil {
+		v.invalid(path, value, "value %q must be numeric", value)
+	}
+}
+
+func (v *validator) oneOf(path, value string, allowed ...string) {
+	if value == "" {
+		return
+	}
+
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+
+	v.invalid(path, value, "value %q is not one of %s", value, strings.Join(allowed, ", "))
+}
+
+// choice enforces an xsd:choice where exactly one of names must be present.
+func (v *validator) choice(path string, names []string, present ...bool) {
+	var found []string
+	for i, ok := range present {
+		if ok {
+			found = append(found, names[i])
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		v.addValue(path, "", DescriptionChoiceViolation, "one of %s is required", strings.Join(names, ", "))
+	case 1:
+	default:
+		v.addValue(path, "", DescriptionChoiceViolation, "only one of %s is allowed, found %s", strings.Join(names, ", "), strings.Join(found, ", "))
+	}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "/" + name
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	dateType = reflect.TypeOf(Date{})
+)
+
+// walkElements calls visit with a pointer to every struct element present
+// in rv along with its element path.
+func walkElements(path string, rv reflect.Value, visit func(path string, x interface{})) {
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			walkElements(fmt.Sprintf("%s[%d]", path, i+1), rv.Index(i), visit)
+		}
+		return
+	case reflect.Struct:
+	default:
+		return
+	}
+
+	if rv.Type() == timeType || rv.Type() == dateType {
+		return
+	}
+
+	visit(path, rv.Addr().Interface())
+
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := rv.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if f.Anonymous && f.Tag.Get("xml") == "" {
+			walkElements(path, fv, visit)
+			continue
+		}
+
+		name, ok := xmlElementName(f)
+		if !ok {
+			continue
+		}
+
+		// Value typed optional elements are absent when zero.
+		if fv.Kind() == reflect.Struct && fv.IsZero() {
+			continue
+		}
+
+		walkElements(join(path, name), fv, visit)
+	}
+}
+
+// xmlElementName returns the element path of a struct field, or false for
+// attributes, character data and XMLName.
+func xmlElementName(f reflect.StructField) (string, bool) {
+	if f.Type == reflect.TypeOf(xml.Name{}) {
+		return "", false
+	}
+
+	tag := f.Tag.Get("xml")
+	if tag == "-" {
+		return "", false
+	}
+
+	parts := strings.Split(tag, ",")
+	for _, flag := range parts[1:] {
+		switch flag {
+		case "attr", "chardata", "innerxml", "comment", "any", "cdata":
+			return "", false
+		}
+	}
+
+	name := parts[0]
+	if name == "" {
+		name = f.Name
+	}
+
+	return strings.ReplaceAll(name, ">", "/"), true
+}
+
+// requiredTransactionElements lists the elements the schema requires in each
+// transaction, by Body element name.
+var requiredTransactionElements = map[string][]string{
+	"NewRx":                  {"Patient", "Pharmacy", "Prescriber", "MedicationPrescribed"},
+	"NewRxRequest":           {"Patient", "Pharmacy", "Prescriber", "MedicationRequested"},
+	"NewRxResponseDenied":    {"Response", "Patient", "Pharmacy", "Prescriber"},
+	"RxRenewalRequest":       {"Patient", "Pharmacy", "Prescriber", "MedicationDispensed"},
+	"RxRenewalResponse":      {"Patient", "Pharmacy", "Prescriber", "MedicationResponse"},
+	"RxChangeRequest":        {"Patient", "Pharmacy", "Prescriber", "MedicationPrescribed"},
+	"RxChangeResponse":       {"Response", "Patient", "Pharmacy", "Prescriber"},
+	"CancelRx":               {"Patient", "Pharmacy", "Prescriber", "MedicationPrescribed"},
+	"CancelRxResponse":       {"Response"},
+	"RxFill":                 {"FillStatus", "Patient", "Pharmacy", "Prescriber"},
+	"RxFillIndicatorChange":  {"Patient", "Pharmacy", "Prescriber", "MedicationPrescribed"},
+	"RxHistoryRequest":       {"Patient", "Prescriber"},
+	"RxHistoryResponse":      {"Patient"},
+	"RxTransferRequest":      {"Patient", "Pharmacy"},
+	"RxTransferResponse":     {"Response", "Patient", "Pharmacy", "TransferPharmacy"},
+	"RxTransferConfirm":      {"Patient", "Pharmacy", "TransferPharmacy"},
+	"PAInitiationRequest":    {"Patient", "Prescriber", "MedicationPrescribed"},
+	"PAInitiationResponse":   {"Patient", "Response"},
+	"PARequest":              {"Patient", "Prescriber", "MedicationPrescribed"},
+	"PAResponse":             {"Patient", "Response"},
+	"PAAppealRequest":        {"Patient", "Prescriber", "MedicationPrescribed"},
+	"PAAppealResponse":       {"Patient", "Response"},
+	"PACancelRequest":        {"Patient", "Prescriber", "MedicationPrescribed"},
+	"PACancelResponse":       {"Response"},
+	"Resupply":               {"Facility", "Patient", "Pharmacy", "MedicationDispensed"},
+	"DrugAdministration":     {"Facility", "Patient", "Pharmacy", "Prescriber", "MedicationPrescribed"},
+	"Census":                 {"Facility", "Patient"},
+	"REMSInitiationRequest":  {"Patient", "Prescriber", "MedicationPrescribed"},
+	"REMSInitiationResponse": {"Patient", "Response"},
+	"REMSRequest":            {"Patient", "Prescriber", "MedicationPrescribed"},
+	"REMSResponse":           {"Patient", "Response"},
+	"ClinicalInfoRequest":    {"Patient", "Pharmacy", "Prescriber"},
+	"ClinicalInfoResponse":   {"Patient", "Pharmacy", "Prescriber"},
+	"Verify":                 {"VerifyStatus"},
+}
+
+func (v *validator) check(path string, x interface{}) {
+	switch x := x.(type) {
+	case *Message:
+		v.required("TransactionDomain", x.TransactionDomain)
+		v.oneOf("TransactionDomain", x.TransactionDomain, "SCRIPT")
+		for _, attr := range []struct{ name, value string }{
+			{"DatatypesVersion", x.DatatypesVersion},
+			{"TransportVersion", x.TransportVersion},
+			{"TransactionVersion", x.TransactionVersion},
+			{"StructuresVersion", x.StructuresVersion},
+			{"ECLVersion", x.ECLVersion},
+		} {
+			v.required(attr.name, attr.value)
+			v.oneOf(attr.name, attr.value, Version)
+		}
+		v.requiredElement("Header", x.Header)
+
+	case *Header:
+		v.required(join(path, "To"), x.To.Value)
+		v.required(join(path, "From"), x.From.Value)
+		v.required(join(path, "MessageID"), x.MessageID)
+		v.maxLen(join(path, "MessageID"), x.MessageID, 35)
+		v.maxLen(join(path, "RelatesToMessageID"), x.RelatesToMessageID, 35)
+		v.maxLen(join(path, "PrescriberOrderNumber"), x.PrescriberOrderNumber, 35)
+		if x.RxReferenceNumber != nil {
+			v.maxLen(join(path, "RxReferenceNumber"), *x.RxReferenceNumber, 35)
+		}
+		if x.SentTime.IsZero() {
+			v.missing(join(path, "SentTime"))
+		}
+		v.required(join(path, "SenderSoftware/SenderSoftwareDeveloper"), x.SenderSoftware.SenderSoftwareDeveloper)
+		v.required(join(path, "SenderSoftware/SenderSoftwareProduct"), x.SenderSoftware.SenderSoftwareProduct)
+		v.required(join(path, "SenderSoftware/SenderSoftwareVersionRelease"), x.SenderSoftware.SenderSoftwareVersionRelease)
+
+	case *Body:
+		v.checkBody(path, x)
+
+	case *Patient:
+		v.choice(path, []string{"HumanPatient", "NonHumanPatient"},
+			!reflect.ValueOf(x.HumanPatient).IsZero(), x.NonHumanPatient != nil)
+
+	case *HumanPatient:
+		v.required(join(path, "Name/LastName"), x.Name.LastName)
+		v.required(join(path, "Name/FirstName"), x.Name.FirstName)
+		v.required(join(path, "Gender"), x.Gender)
+		v.oneOf(join(path, "Gender"), x.Gender, "M", "F", "U")
+		if x.DateOfBirth.Date.IsZero() {
+			v.missing(join(path, "DateOfBirth"))
+		}
+
+	case *NonHumanPatient:
+		v.required(join(path, "Name/LastName"), x.Name.LastName)
+		v.required(join(path, "AnimalName"), x.AnimalName)
+		v.maxLen(join(path, "AnimalName"), x.AnimalName, 35)
+		v.required(join(path, "Species"), x.Species)
+		v.required(join(path, "Gender"), x.Gender)
+		v.oneOf(join(path, "Gender"), x.Gender, "M", "F", "U")
+		if x.DateOfBirth.Date.IsZero() {
+			v.missing(join(path, "DateOfBirth"))
+		}
+
+	case *Name:
+		v.maxLen(join(path, "LastName"), x.LastName, 35)
+		v.maxLen(join(path, "FirstName"), x.FirstName, 35)
+		if x.MiddleName != nil {
+			v.maxLen(join(path, "MiddleName"), *x.MiddleName, 35)
+		}
+		v.maxLen(join(path, "Suffix"), x.Suffix, 10)
+		v.maxLen(join(path, "Prefix"), x.Prefix, 10)
+
+	case *Address:
+		v.required(join(path, "AddressLine1"), x.AddressLine1)
+		v.maxLen(join(path, "AddressLine1"), x.AddressLine1, 40)
+		v.maxLen(join(path, "AddressLine2"), x.AddressLine2, 40)
+		v.required(join(path, "City"), x.City)
+		v.maxLen(join(path, "City"), x.City, 35)
+		v.exactLen(join(path, "StateProvince"), x.StateProvince, 2)
+		v.maxLen(join(path, "PostalCode"), x.PostalCode, 10)
+		v.exactLen(join(path, "CountryCode"), x.CountryCode, 2)
+
+	case *CommunicationNumbers:
+		if x.PrimaryTelephone == nil {
+			v.missing(join(path, "PrimaryTelephone"))
+		}
+		v.maxLen(join(path, "ElectronicMail"), x.ElectronicMail, 80)
+
+	case *Telephone:
+		v.required(join(path, "Number"), x.Number)
+		v.digits(join(path, "Number"), x.Number)
+		v.oneOf(join(path, "SupportsSMS"), x.SupportsSMS, "Y", "N")
+
+	case *Pharmacy:
+		v.requiredElement(join(path, "Identification"), x.Identification)
+		v.required(join(path, "BusinessName"), x.BusinessName)
+		v.maxLen(join(path, "BusinessName"), x.BusinessName, 70)
+		v.requiredElement(join(path, "Address"), x.Address)
+
+	case *TransferPharmacy:
+		v.requiredElement(join(path, "Identification"), x.Identification)
+		v.required(join(path, "BusinessName"), x.BusinessName)
+		v.maxLen(join(path, "BusinessName"), x.BusinessName, 70)
+		v.required(join(path, "Pharmacist/Name/LastName"), x.Pharmacist.Name.LastName)
+
+	case *ProviderIdentification:
+		if x.NCPDPID == "" && x.StateLicenseNumber == "" && x.DEANumber == "" && x.NPI == "" {
+			v.addValue(path, "", DescriptionRequiredElementMissing, "one of NCPDPID, StateLicenseNumber, DEANumber, NPI is required")
+		}
+		v.exactLen(join(path, "NCPDPID"), x.NCPDPID, 7)
+		v.digits(join(path, "NCPDPID"), x.NCPDPID)
+		v.exactLen(join(path, "NPI"), x.NPI, 10)
+		v.digits(join(path, "NPI"), x.NPI)
+		v.exactLen(join(path, "DEANumber"), x.DEANumber, 9)
+
+	case *Prescriber:
+		v.choice(path, []string{"NonVeterinarian", "Veterinarian"},
+			!reflect.ValueOf(x.NonVeterinarian).IsZero(), x.Veterinarian != nil)
+
+	case *Supervisor:
+		v.choice(path, []string{"NonVeterinarian", "Veterinarian"},
+			!reflect.ValueOf(x.NonVeterinarian).IsZero(), x.Veterinarian != nil)
+
+	case *NonVeterinarian:
+		v.requiredElement(join(path, "Identification"), x.Identification)
+		v.required(join(path, "Name/LastName"), x.Name.LastName)
+		v.required(join(path, "Name/FirstName"), x.Name.FirstName)
+
+	case *Veterinarian:
+		v.requiredElement(join(path, "Identification"), x.Identification)
+		v.required(join(path, "Name/LastName"), x.Name.LastName)
+		v.required(join(path, "Name/FirstName"), x.Name.FirstName)
+
+	case *Medication:
+		v.checkMedication(path, x)
+
+	case *Sig:
+		v.maxLen(join(path, "SigText"), x.SigText, 1000)
+
+	case *Coded:
+		v.required(join(path, "Code"), x.Code)
+
+	case *DEASchedule:
+		v.required(join(path, "Code"), x.Code)
+
+	case *Measurement:
+		v.required(join(path, "VitalSign"), x.VitalSign)
+		v.required(join(path, "LOINCVersion"), x.LOINCVersion)
+		v.required(join(path, "Value"), x.Value)
+		v.required(join(path, "UnitOfMeasure"), x.UnitOfMeasure)
+		v.required(join(path, "UCUMVersion"), x.UCUMVersion)
+
+	case *Response:
+		v.choice(path, []string{"Approved", "Replace", "ApprovedWithChanges", "Denied", "Validated"},
+			x.Approved != nil, x.Replace != nil, x.ApprovedWithChanges != nil, x.Denied != nil, x.Validated != nil)
+
+	case *FillStatus:
+		v.choice(path, []string{"Dispensed", "PartiallyDispensed", "NotDispensed", "Transferred"},
+			x.Dispensed != nil, x.PartiallyDispensed != nil, x.NotDispensed != nil, x.Transferred != nil)
+
+	case *PAStatus:
+		v.choice(path, []string{"Open", "Approved", "Denied", "Pended", "Closed"},
+			x.Open != nil, x.Approved != nil, x.Denied != nil, x.Pended != nil, x.Closed != nil)
+
+	case *REMSStatus:
+		v.choice(path, []string{"Open", "Approved", "Denied", "Closed"},
+			x.Open != nil, x.Approved != nil, x.Denied != nil, x.Closed != nil)
+
+	case *RxChangeRequest:
+		v.checkMessageRequestCode(path, x.MessageRequestCode)
+
+	case *RxChangeResponse:
+		v.checkMessageRequestCode(path, x.MessageRequestCode)
+
+	case *RxHistoryRequest:
+		v.oneOf(join(path, "Consent"), x.Consent, "Y", "N", "P")
+
+	case *QuestionSet:
+		v.required(join(path, "QuestionSetID"), x.QuestionSetID)
+		v.required(join(path, "QuestionSetVersion"), x.QuestionSetVersion)
+		if len(x.Question) == 0 {
+			v.missing(join(path, "Question"))
+		}
+
+	case *Question:
+		v.required(join(path, "QuestionID"), x.QuestionID)
+		v.required(join(path, "QuestionText"), x.QuestionText)
+		t := x.QuestionType
+		v.choice(join(path, "QuestionType"), []string{"FreeText", "Numeric", "Date", "Select"},
+			t.FreeText != nil, t.Numeric != nil, t.Date != nil, t.Select != nil)
+
+	case *Comparison:
+		v.required(join(path, "ComparisonOperator"), x.ComparisonOperator)
+		v.oneOf(join(path, "ComparisonOperator"), x.ComparisonOperator,
+			ComparisonEqual, ComparisonNotEqual, ComparisonGreaterThan,
+			ComparisonGreaterThanOrEqual, ComparisonLessThan, ComparisonLessThanOrEqual)
+		v.required(join(path, "NextQuestionID"), x.NextQuestionID)
+
+	case *SelectQuestion:
+		v.oneOf(join(path, "SelectMultiple"), x.SelectMultiple, "Y", "N")
+		if x.SelectMultiple != "Y" && x.PrescriberProvidedAnswer != nil && len(x.PrescriberProvidedAnswer.ChoiceID) > 1 {
+			v.addValue(join(path, "PrescriberProvidedAnswer"), "", DescriptionChoiceViolation, "only one choice is allowed")
+		}
+		if len(x.Choice) == 0 {
+			v.missing(join(path, "Choice"))
+		}
+	}
+}
+
+func (v *validator) checkBody(path string, b *Body) {
+	rv := reflect.ValueOf(b).Elem()
+	t := rv.Type()
+
+	var names []string
+	var present []bool
+	var name string
+	var tx reflect.Value
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Type.Kind() != reflect.Ptr {
+			continue
+		}
+		names = append(names, t.Field(i).Name)
+		present = append(present, !rv.Field(i).IsNil())
+		if !rv.Field(i).IsNil() {
+			name, tx = t.Field(i).Name, rv.Field(i).Elem()
+		}
+	}
+
+	v.choice(path, names, present...)
+	if !tx.IsValid() || tx.Kind() != reflect.Struct {
+		return
+	}
+
+	txPath := join(path, name)
+	for _, element := range requiredTransactionElements[name] {
+		v.requiredElement(join(txPath, element), tx.FieldByName(element).Interface())
+	}
+
+	// The prescribed medication of a transaction must carry its WrittenDate.
+	if m := transactionMedication(tx, "MedicationPrescribed"); m != nil && !reflect.ValueOf(*m).IsZero() {
+		v.requiredElement(join(txPath, "MedicationPrescribed/WrittenDate"), m.WrittenDate)
+	}
+}
+
+// transactionMedication returns the Medication held by the named field of
+// tx, or nil when the transaction has no such field or it is absent.
+func transactionMedication(tx reflect.Value, name string) *Medication {
+	f := reflect.Indirect(tx.FieldByName(name))
+	if !f.IsValid() || !f.CanAddr() {
+		return nil
+	}
+
+	m, _ := f.Addr().Interface().(*Medication)
+	return m
+}
+
+func (v *validator) checkMedication(path string, m *Medication) {
+	v.required(join(path, "DrugDescription"), m.DrugDescription)
+	v.maxLen(join(path, "DrugDescription"), m.DrugDescription, 105)
+	v.required(join(path, "Quantity/CodeListQualifier"), m.Quantity.CodeListQualifier)
+	if m.Quantity.QuantityUnitOfMeasure.Code == nil || *m.Quantity.QuantityUnitOfMeasure.Code == "" {
+		v.missing(join(path, "Quantity/QuantityUnitOfMeasure/Code"))
+	}
+	if m.Quantity.Value < 0 {
+		v.invalid(join(path, "Quantity/Value"), fmt.Sprint(m.Quantity.Value), "value %v must not be negative", m.Quantity.Value)
+	}
+	if m.DaysSupply < 0 {
+		v.invalid(join(path, "DaysSupply"), fmt.Sprint(m.DaysSupply), "value %v must not be negative", m.DaysSupply)
+	}
+	if m.Substitutions != nil && (*m.Substitutions < 0 || *m.Substitutions > 1) {
+		v.invalid(join(path, "Substitutions"), strconv.Itoa(*m.Substitutions), "value %d is not one of 0, 1", *m.Substitutions)
+	}
+	if m.NumberOfRefills != nil && (*m.NumberOfRefills < 0 || *m.NumberOfRefills > 99) {
+		v.invalid(join(path, "NumberOfRefills"), strconv.Itoa(*m.NumberOfRefills), "value %d is out of range 0-99", *m.NumberOfRefills)
+	}
+	v.maxLen(join(path, "Note"), m.Note, 210)
+	v.oneOf(join(path, "RxFillIndicator"), m.RxFillIndicator,
+		RxFillAllFillStatuses, RxFillAllFillStatusesExceptTransferred,
+		RxFillDispensedAndPartiallyDispensed, RxFillPartiallyDispensedAndNotDispensed,
+		RxFillNotDispensedAndTransferred, RxFillPartiallyDispensed, RxFillNotDispensed,
+		RxFillTransferred, RxFillCancelAllFillStatuses)
+}
+
+func (v *validator) checkMessageRequestCode(path, code string) {
+	v.required(join(path, "MessageRequestCode"), code)
+	v.oneOf(join(path, "MessageRequestCode"), code,
+		ChangeGenericSubstitution, ChangeTherapeuticInterchange, ChangePriorAuthorization,
+		ChangeDrugUseEvaluation, ChangeScriptClarification, ChangeOutOfStock,
+		ChangePrescriberAuthorization)
+}
+
+// Validate validates the decoded message. Violations found in XML input
+// carry the line and column of the element they refer to.
+func (d *Decoder) Validate() error {
+	err := Validate(d.msg)
+
+	var errs ValidationErrors
+	if d.isJson || !errors.As(err, &errs) {
+		return err
+	}
+
+	positions := elementPositions(d.buf)
+	for _, e := range errs {
+		e.Line, e.Column = position(d.buf, findPosition(positions, e.Path))
+	}
+
+	return err
+}
+
+// DisallowUnknownElements causes Decode to fail with ValidationErrors when
+// the XML input contains elements that do not map to a field of Message.
+func (d *Decoder) DisallowUnknownElements() {
+	d.strict = true
+}
+
+type xmlNode struct {
+	children map[string]*xmlNode
+	opaque   bool
+}
+
+var unmarshalerType = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+
+func newXMLNode(t reflect.Type, seen map[reflect.Type]*xmlNode) *xmlNode {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	if n, ok := seen[t]; ok {
+		return n
+	}
+
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return &xmlNode{opaque: true}
+	}
+
+	if t.Kind() != reflect.Struct || t == timeType {
+		return &xmlNode{}
+	}
+
+	n := &xmlNode{children: map[string]*xmlNode{}}
+	seen[t] = n
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if f.Anonymous && f.Tag.Get("xml") == "" {
+			for k, c := range newXMLNode(f.Type, seen).children {
+				n.children[k] = c
+			}
+			continue
+		}
+
+		name, ok := xmlElementName(f)
+		if !ok {
+			continue
+		}
+
+		parent := n
+		parts := strings.Split(name, "/")
+		for _, p := range parts[:len(parts)-1] {
+			if parent.children[p] == nil {
+				parent.children[p] = &xmlNode{children: map[string]*xmlNode{}}
+			}
+			parent = parent.children[p]
+		}
+		parent.children[parts[len(parts)-1]] = newXMLNode(f.Type, seen)
+	}
+
+	return n
+}
+
+var messageNode = newXMLNode(reflect.TypeOf(Message{}), map[reflect.Type]*xmlNode{})
+
+func unknownElements(buf []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(buf))
+
+	var errs ValidationErrors
+	var paths []string
+	var nodes []*xmlNode
+	for {
+		before := dec.InputOffset()
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			var path string
+			var node *xmlNode
+			if len(nodes) == 0 {
+				path = tok.Name.Local
+				if path == "Message" {
+					node, path = messageNode, ""
+				}
+			} else {
+				parent := nodes[len(nodes)-1]
+				path = join(paths[len(paths)-1], tok.Name.Local)
+				if parent.opaque {
+					dec.Skip()
+					continue
+				}
+				node = parent.children[tok.Name.Local]
+			}
+
+			if node == nil {
+				line, column := position(buf, before)
+				errs = append(errs, &ValidationError{
+					Path:            path,
+					Line:            line,
+					Column:          column,
+					Message:         "unknown element",
+					Code:            ErrorTransactionRejected,
+					DescriptionCode: DescriptionUnknownElement,
+				})
+				dec.Skip()
+				continue
+			}
+
+			paths = append(paths, path)
+			nodes = append(nodes, node)
+		case xml.EndElement:
+			if len(nodes) > 0 {
+				paths = paths[:len(paths)-1]
+				nodes = nodes[:len(nodes)-1]
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
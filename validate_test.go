@@ -0,0 +1,340 @@
+package ncpdp
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func decodeFile(t *testing.T, name string) *Message {
+	t.Helper()
+
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	msg, err := NewDecoder(file).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return msg
+}
+
+func TestValidateSamples(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range files {
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			file, err := os.Open(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			d := NewDecoder(file)
+			d.DisallowUnknownElements()
+			msg, err := d.Decode()
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			if err := Validate(msg); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(msg *Message)
+		want   []string
+	}{
+		{
+			name:   "valid message",
+			mutate: func(msg *Message) {},
+		},
+		{
+			name: "missing header fields",
+			mutate: func(msg *Message) {
+				msg.Header.MessageID = ""
+				msg.Header.To.Value = ""
+			},
+			want: []string{"Header/To", "Header/MessageID"},
+		},
+		{
+			name: "missing sender software",
+			mutate: func(msg *Message) {
+				msg.Header.SenderSoftware = SenderSoftware{SenderSoftwareProduct: "Certification Testing"}
+			},
+			want: []string{
+				"Header/SenderSoftware/SenderSoftwareDeveloper",
+				"Header/SenderSoftware/SenderSoftwareVersionRelease",
+			},
+		},
+		{
+			name: "missing version attributes",
+			mutate: func(msg *Message) {
+				msg.DatatypesVersion = ""
+				msg.TransportVersion = ""
+				msg.TransactionDomain = ""
+				msg.TransactionVersion = ""
+				msg.StructuresVersion = ""
+				msg.ECLVersion = ""
+			},
+			want: []string{
+				"DatatypesVersion",
+				"TransportVersion",
+				"TransactionDomain",
+				"TransactionVersion",
+				"StructuresVersion",
+				"ECLVersion",
+			},
+		},
+		{
+			name: "empty provider identification",
+			mutate: func(msg *Message) {
+				id := &msg.Body.NewRx.Pharmacy.Identification
+				*id = ProviderIdentification{XMLName: id.XMLName}
+			},
+			want: []string{"Body/NewRx/Pharmacy/Identification"},
+		},
+		{
+			name: "missing pharmacy and prescriber elements",
+			mutate: func(msg *Message) {
+				msg.Body.NewRx.Pharmacy.Identification = ProviderIdentification{}
+				msg.Body.NewRx.Pharmacy.Address = Address{}
+				msg.Body.NewRx.Prescriber.NonVeterinarian.Identification = ProviderIdentification{}
+			},
+			want: []string{
+				"Body/NewRx/Pharmacy/Identification",
+				"Body/NewRx/Pharmacy/Address",
+				"Body/NewRx/Prescriber/NonVeterinarian/Identification",
+			},
+		},
+		{
+			name: "no transaction",
+			mutate: func(msg *Message) {
+				msg.Body.NewRx = nil
+			},
+			want: []string{"Body"},
+		},
+		{
+			name: "multiple transactions",
+			mutate: func(msg *Message) {
+				msg.Body.Status = &Coded{Code: "000"}
+			},
+			want: []string{"Body"},
+		},
+		{
+			name: "missing required transaction element",
+			mutate: func(msg *Message) {
+				msg.Body.NewRx.MedicationPrescribed = Medication{}
+			},
+			want: []string{"Body/NewRx/MedicationPrescribed"},
+		},
+		{
+			name: "patient and prescriber choices",
+			mutate: func(msg *Message) {
+				msg.Body.NewRx.Patient.NonHumanPatient = &NonHumanPatient{
+					Name:        Name{LastName: "Owner"},
+					AnimalName:  "Rex",
+					Species:     "Canine",
+					Gender:      "M",
+					DateOfBirth: msg.Body.NewRx.Patient.HumanPatient.DateOfBirth,
+				}
+				msg.Body.NewRx.Prescriber.NonVeterinarian = NonVeterinarian{}
+			},
+			want: []string{"Body/NewRx/Patient", "Body/NewRx/Prescriber"},
+		},
+		{
+			name: "lengths and enumerations",
+			mutate: func(msg *Message) {
+				p := &msg.Body.NewRx.Patient.HumanPatient
+				p.Name.LastName = strings.Repeat("X", 36)
+				p.Gender = "X"
+				msg.Body.NewRx.Pharmacy.Identification.NPI = "123"
+				msg.TransactionDomain = "OTHER"
+			},
+			want: []string{
+				"TransactionDomain",
+				"Body/NewRx/Patient/HumanPatient/Gender",
+				"Body/NewRx/Patient/HumanPatient/Name/LastName",
+				"Body/NewRx/Pharmacy/Identification/NPI",
+			},
+		},
+		{
+			name: "medication rules",
+			mutate: func(msg *Message) {
+				m := &msg.Body.NewRx.MedicationPrescribed
+				m.DrugDescription = ""
+				m.WrittenDate = WrittenDate{}
+				subs := 5
+				m.Substitutions = &subs
+			},
+			want: []string{
+				"Body/NewRx/MedicationPrescribed/DrugDescription",
+				"Body/NewRx/MedicationPrescribed/WrittenDate",
+				"Body/NewRx/MedicationPrescribed/Substitutions",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := decodeFile(t, "testdata/sample-newrx.xml")
+			tt.mutate(msg)
+
+			err := Validate(msg)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+			}
+
+			got := map[string]bool{}
+			for _, e := range errs {
+				got[e.Path] = true
+			}
+			for _, path := range tt.want {
+				if !got[path] {
+					t.Errorf("Validate() missing violation for %s in %v", path, err)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("Validate() = %v, want violations for %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisallowUnknownElements(t *testing.T) {
+	buf, err := os.ReadFile("testdata/sample-newrx.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := strings.Replace(string(buf), "<LastName>", "<Nickname>Bud</Nickname><LastName>", 1)
+	doc = strings.Replace(doc, "</Header>", "<Priority>1</Priority></Header>", 1)
+
+	if _, err := NewDecoder(strings.NewReader(doc)).Decode(); err != nil {
+		t.Fatalf("Decode() error = %v, want unknown elements ignored", err)
+	}
+
+	d := NewDecoder(strings.NewReader(doc))
+	d.DisallowUnknownElements()
+	_, err = d.Decode()
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Decode() error = %v, want ValidationErrors", err)
+	}
+
+	want := []string{"Header/Priority", "Body/NewRx/Patient/HumanPatient/Name/Nickname"}
+	if len(errs) != len(want) {
+		t.Fatalf("Decode() error = %v, want %v", err, want)
+	}
+	for _, path := range want {
+		found := false
+		for _, e := range errs {
+			found = found || e.Path == path
+		}
+		if !found {
+			t.Errorf("Decode() missing unknown element %s in %v", path, err)
+		}
+	}
+}
+
+func TestUnknownRootElement(t *testing.T) {
+	err := unknownElements([]byte("<Envelope><Header/></Envelope>"))
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "Envelope" {
+		t.Errorf("unknownElements() error = %v, want unknown Envelope", err)
+	}
+}
+
+func TestRequiredTransactionElements(t *testing.T) {
+	body := reflect.TypeOf(Body{})
+	for name, elements := range requiredTransactionElements {
+		f, ok := body.FieldByName(name)
+		if !ok {
+			t.Errorf("Body has no %s transaction", name)
+			continue
+		}
+		for _, element := range elements {
+			if _, ok := f.Type.Elem().FieldByName(element); !ok {
+				t.Errorf("%s has no %s element", name, element)
+			}
+		}
+	}
+}
+
+func TestValidateTransactionContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		mutate func(msg *Message)
+		want   string
+	}{
+		{
+			name: "optional pointer medication without written date",
+			file: "testdata/sample-rxchangeresponse.xml",
+			mutate: func(msg *Message) {
+				msg.Body.RxChangeResponse.MedicationPrescribed.WrittenDate = WrittenDate{}
+			},
+			want: "Body/RxChangeResponse/MedicationPrescribed/WrittenDate",
+		},
+		{
+			name: "optional medication absent",
+			file: "testdata/sample-rxchangeresponse.xml",
+			mutate: func(msg *Message) {
+				msg.Body.RxChangeResponse.MedicationPrescribed = nil
+			},
+		},
+		{
+			name: "required element",
+			file: "testdata/sample-rxchangeresponse.xml",
+			mutate: func(msg *Message) {
+				msg.Body.RxChangeResponse.Response = Response{}
+			},
+			want: "Body/RxChangeResponse/Response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := decodeFile(t, tt.file)
+			tt.mutate(msg)
+
+			err := Validate(msg)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != tt.want {
+				t.Errorf("Validate() error = %v, want violation for %s", err, tt.want)
+			}
+		})
+	}
+}